@@ -0,0 +1,398 @@
+// apo-analyze runs slow and error analysis of a trace offline, with the adapter responses and
+// the sampled traces saved as json files, so the verdict of a customer bundle can be reproduced.
+//
+//	apo-analyze -list list.json -detail detail.json -traces traces.json -mode maxService -ratio 10
+//	apo-analyze -record ./records -trace <traceId> -format json
+//	apo-analyze -record ./records -trace <traceId> -agent-events events.json
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	client "github.com/CloudDetail/apo-module/apm/client/v1"
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+const (
+	analysisSlow  = "slow"
+	analysisError = "error"
+	analysisAll   = "all"
+
+	formatText = "text"
+	formatJson = "json"
+
+	modeSingle     = "single"
+	modeMaxService = "maxService"
+	modeTop3       = "top3"
+)
+
+type options struct {
+	listFile    string
+	detailFiles []string
+	tracesFile  string
+	recordDir   string
+	traceId     string
+	eventsFile  string
+
+	analysis string
+	mode     string
+	ratio    int
+	format   string
+	width    int
+	depth    int
+	color    bool
+}
+
+type slowResult struct {
+	Tree         *model.TraceTreeNode   `json:"tree,omitempty"`
+	BlamedNode   *blamedNode            `json:"blamedNode,omitempty"`
+	ClientCalls  []*model.ApmClientCall `json:"clientCalls,omitempty"`
+	Explanations []string               `json:"explanations,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+type errorResult struct {
+	Tree         *model.ErrorTreeNode `json:"tree,omitempty"`
+	BlamedNode   *blamedNode          `json:"blamedNode,omitempty"`
+	Explanations []string             `json:"explanations,omitempty"`
+	Error        string               `json:"error,omitempty"`
+}
+
+type blamedNode struct {
+	Id           string `json:"id"`
+	InstanceKey  string `json:"instanceKey,omitempty"`
+	ServiceName  string `json:"serviceName"`
+	Url          string `json:"url"`
+	SpanId       string `json:"spanId"`
+	TotalTime    uint64 `json:"totalTime"`
+	SelfTime     uint64 `json:"selfTime,omitempty"`
+	P90          uint64 `json:"p90,omitempty"`
+	MutatedValue int64  `json:"mutatedValue,omitempty"`
+	Cause        string `json:"cause,omitempty"`
+}
+
+type analysisResult struct {
+	TraceId string       `json:"traceId"`
+	Mode    string       `json:"mode"`
+	Ratio   int          `json:"ratio"`
+	Slow    *slowResult  `json:"slow,omitempty"`
+	Error   *errorResult `json:"error,omitempty"`
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, out io.Writer) error {
+	opts, err := parseOptions(args)
+	if err != nil {
+		return err
+	}
+	adapter, traces, err := loadInput(opts)
+	if err != nil {
+		return err
+	}
+
+	var detailTypes []string
+	if len(opts.detailFiles) > 0 || opts.recordDir != "" {
+		detailTypes = []string{traces.RootTrace.Labels.ApmType}
+	}
+	apmClient := client.NewApmTraceClientByAPI(adapter, opts.ratio, opts.mode, detailTypes)
+	if opts.eventsFile != "" {
+		registry, err := loadAgentEvents(opts.eventsFile)
+		if err != nil {
+			return err
+		}
+		apmClient.SetAgentEventRegistry(registry)
+	}
+	result := analyze(context.Background(), apmClient, traces, opts)
+
+	if opts.format == formatJson {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+	return printText(out, result, opts)
+}
+
+func parseOptions(args []string) (*options, error) {
+	opts := &options{}
+	var detailFiles string
+	flags := flag.NewFlagSet("apo-analyze", flag.ContinueOnError)
+	flags.StringVar(&opts.listFile, "list", "", "json file of adapter TraceListResponse")
+	flags.StringVar(&detailFiles, "detail", "", "comma separated json files of adapter TraceDetailResponse")
+	flags.StringVar(&opts.tracesFile, "traces", "", "json file of kindling traces, model.Traces or array of model.Trace")
+	flags.StringVar(&opts.recordDir, "record", "", "directory written by RecordAdapter, used instead of -list, -detail and -traces")
+	flags.StringVar(&opts.traceId, "trace", "", "traceId to analyze, required by -record and overrides traceId of -traces")
+	flags.StringVar(&opts.eventsFile, "agent-events", "", "json file of agent events, array of model.AgentEvent, explains why nodes are not profiled")
+	flags.StringVar(&opts.analysis, "analysis", analysisAll, "analysis to run: slow, error or all")
+	flags.StringVar(&opts.mode, "mode", modeMaxService, "mutated node mode: single, maxService or top3")
+	flags.IntVar(&opts.ratio, "ratio", 10, "min percent of self time in trace duration for mutated node")
+	flags.StringVar(&opts.format, "format", formatText, "output format: text or json")
+	flags.IntVar(&opts.width, "width", model.DefaultTreeRenderOptions().MaxWidth, "max width of tree lines, 0 is unlimited")
+	flags.IntVar(&opts.depth, "depth", 0, "max depth of tree, 0 is unlimited")
+	flags.BoolVar(&opts.color, "color", false, "color tree with ANSI escape codes")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if detailFiles != "" {
+		opts.detailFiles = strings.Split(detailFiles, ",")
+	}
+
+	if opts.recordDir != "" {
+		if opts.traceId == "" {
+			return nil, errors.New("-trace is required by -record")
+		}
+	} else if opts.listFile == "" || opts.tracesFile == "" {
+		return nil, errors.New("-list and -traces are required, or use -record")
+	}
+	switch opts.analysis {
+	case analysisSlow, analysisError, analysisAll:
+	default:
+		return nil, fmt.Errorf("unknown analysis %q", opts.analysis)
+	}
+	switch opts.mode {
+	case modeSingle, modeMaxService, modeTop3:
+	default:
+		return nil, fmt.Errorf("unknown mode %q", opts.mode)
+	}
+	switch opts.format {
+	case formatText, formatJson:
+	default:
+		return nil, fmt.Errorf("unknown format %q", opts.format)
+	}
+	return opts, nil
+}
+
+func loadInput(opts *options) (api.AdapterAPI, *model.Traces, error) {
+	if opts.recordDir != "" {
+		replay, err := client.NewReplayAdapter(opts.recordDir)
+		if err != nil {
+			return nil, nil, err
+		}
+		traces, err := replay.LoadTraces(opts.traceId)
+		if err != nil {
+			return nil, nil, err
+		}
+		if traces.RootTrace == nil {
+			return nil, nil, fmt.Errorf("traces of %s has no top span trace", opts.traceId)
+		}
+		return replay, traces, nil
+	}
+
+	adapter, err := client.NewFileAdapter(opts.listFile, opts.detailFiles...)
+	if err != nil {
+		return nil, nil, err
+	}
+	traces, err := client.LoadTracesFile(opts.tracesFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	if opts.traceId != "" {
+		traces.TraceId = opts.traceId
+	}
+	return adapter, traces, nil
+}
+
+func loadAgentEvents(file string) (*model.AgentEventRegistry, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var events []*model.AgentEvent
+	if err := json.Unmarshal(data, &events); err != nil {
+		return nil, fmt.Errorf("parse agent events %s: %w", file, err)
+	}
+	registry := model.NewAgentEventRegistry(nil)
+	for _, event := range events {
+		registry.AddEvent(event)
+	}
+	return registry, nil
+}
+
+func analyze(ctx context.Context, apmClient *client.ApmTraceClient, traces *model.Traces, opts *options) *analysisResult {
+	result := &analysisResult{
+		TraceId: traces.TraceId,
+		Mode:    opts.mode,
+		Ratio:   opts.ratio,
+	}
+	if opts.analysis != analysisError {
+		result.Slow = analyzeSlow(ctx, apmClient, traces)
+	}
+	if opts.analysis != analysisSlow {
+		result.Error = analyzeError(ctx, apmClient, traces)
+	}
+	return result
+}
+
+func analyzeSlow(ctx context.Context, apmClient *client.ApmTraceClient, traces *model.Traces) *slowResult {
+	root, clientCalls, err := apmClient.QueryMutatedSlowTraceTree(ctx, "", traces.TraceId, traces)
+	if err != nil {
+		return &slowResult{Error: err.Error()}
+	}
+	result := &slowResult{
+		Tree:         root,
+		ClientCalls:  clientCalls,
+		Explanations: make([]string, 0),
+	}
+	if mutated := root.GetMutatedNode(); mutated != nil {
+		result.BlamedNode = &blamedNode{
+			Id:           mutated.Id,
+			InstanceKey:  mutated.InstanceKey,
+			ServiceName:  mutated.ServiceName,
+			Url:          mutated.Url,
+			SpanId:       mutated.SpanId,
+			TotalTime:    mutated.TotalTime,
+			SelfTime:     mutated.SelfTime,
+			P90:          mutated.P90,
+			MutatedValue: mutated.MutatedValue,
+		}
+		for _, pattern := range mutated.CallPatterns {
+			result.Explanations = append(result.Explanations, fmt.Sprintf("%s: %s", pattern.Type, pattern.Message))
+		}
+		if mutated.CpuBreakdown != nil {
+			result.BlamedNode.Cause = string(mutated.CpuBreakdown.Verdict)
+			result.Explanations = append(result.Explanations, mutated.CpuBreakdown.Message)
+		}
+	}
+	walkTraceTree(root, func(node *model.TraceTreeNode) {
+		result.Explanations = append(result.Explanations, explainNode(node.ServiceName, node.Url, node.IsTraced, node.IsProfiled, node.NotProfiledReason)...)
+		if node.IsTraced && node.P90Source == model.P90FromPQL {
+			result.Explanations = append(result.Explanations, fmt.Sprintf("%s %s: p90 is queried by pql", node.ServiceName, node.Url))
+		}
+	})
+	return result
+}
+
+func analyzeError(ctx context.Context, apmClient *client.ApmTraceClient, traces *model.Traces) *errorResult {
+	root, err := apmClient.QueryErrorTraceTree(ctx, "", traces.TraceId, traces)
+	if err != nil {
+		return &errorResult{Error: err.Error()}
+	}
+	result := &errorResult{
+		Tree:         root,
+		Explanations: make([]string, 0),
+	}
+	if mutated := root.GetMutatedNode(); mutated != nil {
+		result.BlamedNode = &blamedNode{
+			Id:          mutated.Id,
+			InstanceKey: mutated.InstanceKey,
+			ServiceName: mutated.ServiceName,
+			Url:         mutated.Url,
+			SpanId:      mutated.SpanId,
+			TotalTime:   mutated.TotalTime,
+		}
+		if exception := mutated.GetRootCauseError(); exception != nil {
+			result.BlamedNode.Cause = exception.Type
+			result.Explanations = append(result.Explanations, fmt.Sprintf("root cause exception %s: %s", exception.Type, exception.Message))
+		}
+	}
+	walkErrorTree(root, func(node *model.ErrorTreeNode) {
+		result.Explanations = append(result.Explanations, explainNode(node.ServiceName, node.Url, node.IsTraced, node.IsProfiled, node.NotProfiledReason)...)
+	})
+	return result
+}
+
+func explainNode(serviceName string, url string, isTraced bool, isProfiled bool, notProfiledReason string) []string {
+	if !isTraced {
+		return nil
+	}
+	if notProfiledReason != "" {
+		return []string{fmt.Sprintf("%s %s: not profiled, %s", serviceName, url, notProfiledReason)}
+	}
+	if !isProfiled {
+		return []string{fmt.Sprintf("%s %s: traced but not profiled", serviceName, url)}
+	}
+	return nil
+}
+
+func walkTraceTree(node *model.TraceTreeNode, fn func(node *model.TraceTreeNode)) {
+	fn(node)
+	for _, child := range node.Children {
+		walkTraceTree(child, fn)
+	}
+}
+
+func walkErrorTree(node *model.ErrorTreeNode, fn func(node *model.ErrorTreeNode)) {
+	fn(node)
+	for _, child := range node.Children {
+		walkErrorTree(child, fn)
+	}
+}
+
+func printText(out io.Writer, result *analysisResult, opts *options) error {
+	renderOpts := &model.TreeRenderOptions{
+		MaxWidth: opts.width,
+		MaxDepth: opts.depth,
+		Color:    opts.color,
+	}
+	var text strings.Builder
+	fmt.Fprintf(&text, "trace: %s, mode: %s, ratio: %d%%\n", result.TraceId, result.Mode, result.Ratio)
+	if slow := result.Slow; slow != nil {
+		text.WriteString("\n== Slow Analysis ==\n")
+		if slow.Error != "" {
+			fmt.Fprintf(&text, "no mutated node: %s\n", slow.Error)
+		} else {
+			text.WriteString(slow.Tree.RenderString(renderOpts))
+			writeBlamedNode(&text, slow.BlamedNode)
+			if len(slow.ClientCalls) > 0 {
+				text.WriteString("client calls:\n")
+				for _, call := range slow.ClientCalls {
+					fmt.Fprintf(&text, "  %s %.2fms", call.ClientName, float64(call.ClientEndTime-call.ClientStartTime)/1e6)
+					if call.ServerName != "" {
+						fmt.Fprintf(&text, " -> %s %.2fms", call.ServerName, float64(call.ServerDuration)/1e6)
+					}
+					text.WriteString("\n")
+				}
+			}
+			writeExplanations(&text, slow.Explanations)
+		}
+	}
+	if errorResult := result.Error; errorResult != nil {
+		text.WriteString("\n== Error Analysis ==\n")
+		if errorResult.Error != "" {
+			fmt.Fprintf(&text, "no root cause node: %s\n", errorResult.Error)
+		} else {
+			text.WriteString(errorResult.Tree.RenderString(renderOpts))
+			writeBlamedNode(&text, errorResult.BlamedNode)
+			writeExplanations(&text, errorResult.Explanations)
+		}
+	}
+	_, err := io.WriteString(out, text.String())
+	return err
+}
+
+func writeBlamedNode(text *strings.Builder, node *blamedNode) {
+	if node == nil {
+		return
+	}
+	fmt.Fprintf(text, "blamed node: %s %s (instance: %s, span: %s)\n", node.ServiceName, node.Url, node.InstanceKey, node.SpanId)
+	if node.SelfTime > 0 || node.P90 > 0 {
+		fmt.Fprintf(text, "  total: %.2fms, self: %.2fms, p90: %.2fms, mutated: %.2fms\n",
+			float64(node.TotalTime)/1e6, float64(node.SelfTime)/1e6, float64(node.P90)/1e6, float64(node.MutatedValue)/1e6)
+	}
+	if node.Cause != "" {
+		fmt.Fprintf(text, "  cause: %s\n", node.Cause)
+	}
+}
+
+func writeExplanations(text *strings.Builder, explanations []string) {
+	if len(explanations) == 0 {
+		return
+	}
+	text.WriteString("explanations:\n")
+	for _, explanation := range explanations {
+		fmt.Fprintf(text, "  - %s\n", explanation)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseOptions(t *testing.T) {
+	input := []string{"-list", "list.json", "-traces", "traces.json"}
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"default", input, ""},
+		{"single", append([]string{"-mode", "single"}, input...), ""},
+		{"top3", append([]string{"-mode", "top3"}, input...), ""},
+		{"unknown mode", append([]string{"-mode", "max"}, input...), `unknown mode "max"`},
+		{"unknown analysis", append([]string{"-analysis", "cpu"}, input...), `unknown analysis "cpu"`},
+		{"unknown format", append([]string{"-format", "yaml"}, input...), `unknown format "yaml"`},
+		{"no input", nil, "-list and -traces are required"},
+		{"record without trace", []string{"-record", "records"}, "-trace is required by -record"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseOptions(tt.args)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("parseOptions() error = %v", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("parseOptions() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+var fixtureArgs = []string{
+	"-list", "testdata/list.json",
+	"-detail", "testdata/detail.json",
+	"-traces", "testdata/traces.json",
+	"-agent-events", "testdata/agent_events.json",
+}
+
+func TestRun_Text(t *testing.T) {
+	var out strings.Builder
+	if err := run(fixtureArgs, &out); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		"trace: t1, mode: maxService, ratio: 10%\n",
+		"└── order GET /order/{id} 178.00ms self=178.00ms p90=20.00ms [path] [MUTATED]\n",
+		"blamed node: order GET /order/{id} (instance: pod:shop/order-0, span: s3)\n",
+		"  - order GET /order/{id}: not profiled, profiling is off since ",
+		"no root cause node: trace[t1] has no traced trace\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestRun_Json(t *testing.T) {
+	var out strings.Builder
+	if err := run(append([]string{"-format", "json", "-analysis", "slow", "-mode", "single"}, fixtureArgs...), &out); err != nil {
+		t.Fatal(err)
+	}
+	var result analysisResult
+	if err := json.Unmarshal([]byte(out.String()), &result); err != nil {
+		t.Fatalf("output is not json: %v\n%s", err, out.String())
+	}
+	if result.Mode != "single" || result.Error != nil || result.Slow == nil {
+		t.Fatalf("result = %+v", result)
+	}
+	if node := result.Slow.BlamedNode; node == nil || node.SpanId != "s3" || node.InstanceKey != "pod:shop/order-0" {
+		t.Errorf("blamed node = %+v, want s3 of pod:shop/order-0", node)
+	}
+}
@@ -5,10 +5,21 @@ go 1.21
 require (
 	github.com/CloudDetail/apo-module/apm/model v0.0.0-00000000000000-000000000000
 	github.com/CloudDetail/apo-module/model v0.0.0-00000000000000-000000000000
+	github.com/prometheus/client_golang v1.19.1
 	github.com/xwb1989/sqlparser v0.0.0-20180606152119-120387863bf2
 )
 
-require go.opentelemetry.io/collector/semconv v0.97.0 // indirect
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/prometheus/client_model v0.5.0 // indirect
+	github.com/prometheus/common v0.48.0 // indirect
+	github.com/prometheus/procfs v0.12.0 // indirect
+	go.opentelemetry.io/collector/semconv v0.97.0 // indirect
+	golang.org/x/sys v0.17.0 // indirect
+	google.golang.org/protobuf v1.33.0 // indirect
+)
 
 replace (
 	github.com/CloudDetail/apo-module/apm/model => ../model
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -13,14 +14,17 @@ import (
 	"github.com/CloudDetail/apo-module/apm/model/v1"
 )
 
-var _ api.AdapterAPI = &AdapterHTTPClient{}
+var _ api.BatchAdapterAPI = &AdapterHTTPClient{}
 
 type AdapterHTTPClient struct {
-	TraceListAddress   string
-	TraceDetailAddress string
-	Timeout            time.Duration
-
-	client *http.Client
+	TraceListAddress      string
+	TraceDetailAddress    string
+	TraceBatchListAddress string
+	Timeout               time.Duration
+
+	auth    *AdapterAuth
+	headers map[string]string
+	client  *http.Client
 }
 
 type QueryParams struct {
@@ -35,37 +39,68 @@ type QueryParams struct {
 func NewAdapterHTTPClient(address string, timeout int64) *AdapterHTTPClient {
 	timeoutDuration := time.Duration(timeout) * time.Second
 	return &AdapterHTTPClient{
-		TraceListAddress:   fmt.Sprintf("http://%s/trace/list", address),
-		TraceDetailAddress: fmt.Sprintf("http://%s/trace/detail", address),
-		Timeout:            timeoutDuration,
-		client:             &http.Client{Timeout: timeoutDuration},
+		TraceListAddress:      fmt.Sprintf("http://%s/trace/list", address),
+		TraceDetailAddress:    fmt.Sprintf("http://%s/trace/detail", address),
+		TraceBatchListAddress: fmt.Sprintf("http://%s/trace/batchList", address),
+		Timeout:               timeoutDuration,
+		client:                &http.Client{Timeout: timeoutDuration},
 	}
 }
 
-func (c *AdapterHTTPClient) SetRountTriper(rt http.RoundTripper) {
-	c.client.Transport = rt
-}
-
-func (c *AdapterHTTPClient) QueryList(ctx context.Context, queryParams *api.QueryParams) ([]*model.OtelServiceNode, error) {
-	requestBody, err := json.Marshal(&queryParams)
+func NewAdapterHTTPClientWithConfig(cfg *AdapterConfig) (*AdapterHTTPClient, error) {
+	if cfg == nil {
+		return nil, errors.New("adapter config can not be nil")
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	tlsConfig, err := cfg.TLS.LoadTLSConfig()
 	if err != nil {
-		return nil, fmt.Errorf("query param is invalid, %s", err)
+		return nil, fmt.Errorf("error loading tls config: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.TraceListAddress, bytes.NewReader(requestBody))
-	if err != nil {
-		return nil, err
+	timeoutDuration := time.Duration(cfg.Timeout) * time.Second
+	httpClient := &http.Client{Timeout: timeoutDuration}
+	if tlsConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		httpClient.Transport = transport
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, err
+	baseUrl := cfg.getBaseUrl()
+	return &AdapterHTTPClient{
+		TraceListAddress:      fmt.Sprintf("%s/trace/list", baseUrl),
+		TraceDetailAddress:    fmt.Sprintf("%s/trace/detail", baseUrl),
+		TraceBatchListAddress: fmt.Sprintf("%s/trace/batchList", baseUrl),
+		Timeout:               timeoutDuration,
+		auth:                  cfg.Auth,
+		headers:               cfg.Headers,
+		client:                httpClient,
+	}, nil
+}
+
+// RoundTripper returns the transport of client, which carries the tls config of AdapterConfig.
+// Wrap it and pass to SetRoundTripper to add tracing or metrics while keeping https and mtls.
+func (c *AdapterHTTPClient) RoundTripper() http.RoundTripper {
+	if c.client.Transport == nil {
+		return http.DefaultTransport
 	}
-	defer resp.Body.Close()
+	return c.client.Transport
+}
 
+// SetRoundTripper replaces the transport of client including its tls config, see RoundTripper.
+func (c *AdapterHTTPClient) SetRoundTripper(rt http.RoundTripper) {
+	c.client.Transport = rt
+}
+
+// Deprecated: Use SetRoundTripper
+func (c *AdapterHTTPClient) SetRountTriper(rt http.RoundTripper) {
+	c.SetRoundTripper(rt)
+}
+
+func (c *AdapterHTTPClient) QueryList(ctx context.Context, queryParams *api.QueryParams) ([]*model.OtelServiceNode, error) {
 	var response api.TraceListResponse
-	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+	if err := c.post(ctx, c.TraceListAddress, queryParams, &response); err != nil {
 		return nil, err
 	}
 	if !response.Success {
@@ -78,29 +113,95 @@ func (c *AdapterHTTPClient) QueryList(ctx context.Context, queryParams *api.Quer
 }
 
 func (c *AdapterHTTPClient) QueryDetail(ctx context.Context, queryParams *api.QueryParams) ([]*model.OtelSpan, error) {
-	requestBody, err := json.Marshal(&queryParams)
-	if err != nil {
-		return nil, fmt.Errorf("query param is invalid, %s", err)
+	var response api.TraceDetailResponse
+	if err := c.post(ctx, c.TraceDetailAddress, queryParams, &response); err != nil {
+		return nil, err
 	}
+	if !response.Success {
+		return nil, errors.New(response.ErrorMsg)
+	}
+	return response.Data, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.TraceDetailAddress, bytes.NewReader(requestBody))
+func (c *AdapterHTTPClient) QueryBatchList(ctx context.Context, queryParams []*api.QueryParams, handler api.BatchListHandler) error {
+	resp, err := c.doPost(ctx, c.TraceBatchListAddress, &api.BatchQueryParams{Queries: queryParams})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	req.Header.Set("Content-Type", "application/json")
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("request %s failed, status: %s, body: %s", c.TraceBatchListAddress, resp.Status, body)
+	}
+
+	pending := make(map[string]bool, len(queryParams))
+	for _, params := range queryParams {
+		pending[params.TraceId] = true
+	}
+	// Items are streamed one by one, so the finished traces are handled before the whole batch is received.
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var item api.TraceBatchListResponse
+		if err := decoder.Decode(&item); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return fmt.Errorf("decode batch list failed, received %d/%d traces: %w", len(queryParams)-len(pending), len(queryParams), err)
+		}
+		if !pending[item.TraceId] {
+			continue
+		}
+		delete(pending, item.TraceId)
+		if !item.Success {
+			handler(item.TraceId, nil, errors.New(item.ErrorMsg))
+		} else if len(item.Data) == 0 {
+			handler(item.TraceId, nil, fmt.Errorf("[x Trace NotFound] traceId: %s", item.TraceId))
+		} else {
+			handler(item.TraceId, item.Data, nil)
+		}
+	}
+	// Traces not returned by adapter are left to the caller, eg. to be queried one by one.
+	return nil
+}
+
+type adapterErrorResponse struct {
+	ErrorMsg string `json:"errorMsg"`
+}
 
-	resp, err := c.client.Do(req)
+func (c *AdapterHTTPClient) post(ctx context.Context, address string, queryParams interface{}, response interface{}) error {
+	resp, err := c.doPost(ctx, address, queryParams)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 
-	var response api.TraceDetailResponse
-	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
+	if resp.StatusCode >= http.StatusBadRequest {
+		// Adapter reports its own errors as json with errorMsg, gateways (auth, proxy) don't.
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		var adapterErr adapterErrorResponse
+		if json.Unmarshal(body, &adapterErr) == nil && len(adapterErr.ErrorMsg) > 0 {
+			return fmt.Errorf("request %s failed, status: %s, error: %s", address, resp.Status, adapterErr.ErrorMsg)
+		}
+		return fmt.Errorf("request %s failed, status: %s, body: %s", address, resp.Status, body)
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+func (c *AdapterHTTPClient) doPost(ctx context.Context, address string, queryParams interface{}) (*http.Response, error) {
+	requestBody, err := json.Marshal(queryParams)
+	if err != nil {
+		return nil, fmt.Errorf("query param is invalid, %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address, bytes.NewReader(requestBody))
+	if err != nil {
 		return nil, err
 	}
-	if !response.Success {
-		return nil, errors.New(response.ErrorMsg)
+	for k, v := range c.headers {
+		req.Header.Set(k, v)
 	}
-	return response.Data, nil
+	req.Header.Set("Content-Type", "application/json")
+	c.auth.apply(req)
+
+	return c.client.Do(req)
 }
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+)
+
+func TestAdapterConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *AdapterConfig
+		wantUrl string
+		wantErr bool
+	}{
+		{"host", &AdapterConfig{Address: "adapter:8080"}, "http://adapter:8080", false},
+		{"tls host", &AdapterConfig{Address: "adapter:8080", TLS: &TLSConfig{Enable: true}}, "https://adapter:8080", false},
+		{"base path", &AdapterConfig{Address: "https://adapter/", BasePath: "/apo/adapter/"}, "https://adapter/apo/adapter", false},
+		{"tls with http", &AdapterConfig{Address: "http://adapter:8080", TLS: &TLSConfig{Enable: true}}, "", true},
+		{"empty address", &AdapterConfig{}, "", true},
+		{"both auth", &AdapterConfig{Address: "adapter", Auth: &AdapterAuth{BearerToken: "t", Username: "u"}}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && tt.cfg.getBaseUrl() != tt.wantUrl {
+				t.Errorf("getBaseUrl() = %s, want %s", tt.cfg.getBaseUrl(), tt.wantUrl)
+			}
+		})
+	}
+}
+
+func TestAdapterHTTPClient_QueryList(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{"success", http.StatusOK, `{"success": true, "data": [{"entrySpans": [{"spanId": "s1"}]}]}`, ""},
+		{"adapter error", http.StatusOK, `{"success": false, "errorMsg": "apm is down"}`, "apm is down"},
+		{"not found", http.StatusOK, `{"success": true, "data": []}`, "[x Trace NotFound]"},
+		{"adapter status error", http.StatusInternalServerError, `{"success": false, "errorMsg": "apm is down"}`, "status: 500 Internal Server Error, error: apm is down"},
+		{"gateway json error", http.StatusUnauthorized, `{"message": "invalid token"}`, `status: 401 Unauthorized, body: {"message": "invalid token"}`},
+		{"gateway text error", http.StatusBadGateway, `bad gateway`, "status: 502 Bad Gateway, body: bad gateway"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var authorization, header string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization = r.Header.Get("Authorization")
+				header = r.Header.Get("X-Tenant")
+				if r.URL.Path != "/apo/trace/list" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := NewAdapterHTTPClientWithConfig(&AdapterConfig{
+				Address:  server.URL,
+				BasePath: "apo",
+				Timeout:  5,
+				Auth:     &AdapterAuth{BearerToken: "token"},
+				Headers:  map[string]string{"X-Tenant": "t1"},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			nodes, err := client.QueryList(context.Background(), &api.QueryParams{TraceId: "t1"})
+			if tt.wantErr == "" {
+				if err != nil || len(nodes) != 1 {
+					t.Fatalf("QueryList() = %v, %v", nodes, err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("QueryList() error = %v, want %q", err, tt.wantErr)
+			}
+			if authorization != "Bearer token" || header != "t1" {
+				t.Errorf("request headers = %q, %q", authorization, header)
+			}
+		})
+	}
+}
+
+type countingRoundTripper struct {
+	next  http.RoundTripper
+	count int
+}
+
+func (c *countingRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	c.count++
+	return c.next.RoundTrip(r)
+}
+
+func TestAdapterHTTPClient_RoundTripper(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success": true, "data": [{"entrySpans": [{"spanId": "s1"}]}]}`))
+	}))
+	defer server.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := NewAdapterHTTPClientWithConfig(&AdapterConfig{
+		Address: server.URL,
+		Timeout: 5,
+		TLS:     &TLSConfig{Enable: true, CAFile: caFile},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Wrapped transport keeps the ca of config.
+	wrapper := &countingRoundTripper{next: client.RoundTripper()}
+	client.SetRoundTripper(wrapper)
+	if _, err := client.QueryList(context.Background(), &api.QueryParams{TraceId: "t1"}); err != nil || wrapper.count != 1 {
+		t.Fatalf("QueryList() with wrapped transport = %v, calls = %d", err, wrapper.count)
+	}
+	// Replaced transport drops the tls config.
+	client.SetRoundTripper(&countingRoundTripper{next: http.DefaultTransport})
+	if _, err := client.QueryList(context.Background(), &api.QueryParams{TraceId: "t1"}); err == nil {
+		t.Errorf("QueryList() with default transport want certificate error")
+	}
+}
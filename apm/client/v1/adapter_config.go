@@ -0,0 +1,65 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type AdapterConfig struct {
+	// Address is host:port of the adapter, a scheme prefix (http:// or https://) is also accepted.
+	Address string `mapstructure:"address"`
+	// BasePath is prepended to /trace/list and /trace/detail, eg. /apo/adapter
+	BasePath string            `mapstructure:"base_path"`
+	Timeout  int64             `mapstructure:"timeout"`
+	TLS      *TLSConfig        `mapstructure:"tls"`
+	Auth     *AdapterAuth      `mapstructure:"auth"`
+	Headers  map[string]string `mapstructure:"headers"`
+}
+
+type AdapterAuth struct {
+	BearerToken string `mapstructure:"bearer_token"`
+	Username    string `mapstructure:"username"`
+	Password    string `mapstructure:"password"`
+}
+
+func (auth *AdapterAuth) apply(req *http.Request) {
+	if auth == nil {
+		return
+	}
+	if len(auth.BearerToken) > 0 {
+		req.Header.Set("Authorization", "Bearer "+auth.BearerToken)
+	} else if len(auth.Username) > 0 {
+		req.SetBasicAuth(auth.Username, auth.Password)
+	}
+}
+
+func (cfg *AdapterConfig) validate() error {
+	if len(cfg.Address) == 0 {
+		return fmt.Errorf("adapter address is required")
+	}
+	if cfg.Auth != nil && len(cfg.Auth.BearerToken) > 0 && len(cfg.Auth.Username) > 0 {
+		return fmt.Errorf("adapter auth should be either bearer_token or username/password, not both")
+	}
+	if cfg.TLS != nil && cfg.TLS.Enable && strings.HasPrefix(cfg.Address, "http://") {
+		return fmt.Errorf("adapter address %s is http but tls is enabled", cfg.Address)
+	}
+	return nil
+}
+
+func (cfg *AdapterConfig) getBaseUrl() string {
+	address := strings.TrimSuffix(cfg.Address, "/")
+	if !strings.HasPrefix(address, "http://") && !strings.HasPrefix(address, "https://") {
+		if cfg.TLS != nil && cfg.TLS.Enable {
+			address = "https://" + address
+		} else {
+			address = "http://" + address
+		}
+	}
+
+	basePath := strings.Trim(cfg.BasePath, "/")
+	if len(basePath) == 0 {
+		return address
+	}
+	return fmt.Sprintf("%s/%s", address, basePath)
+}
@@ -0,0 +1,255 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+	apmmodel "github.com/CloudDetail/apo-module/apm/model/v1"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+var (
+	_ api.AdapterAPI = &RecordAdapter{}
+	_ api.AdapterAPI = &ReplayAdapter{}
+
+	ErrNotRecorded      error = errors.New("no record is found")
+	ErrInvalidRecordDir error = errors.New("traceId can not be used as record dir")
+)
+
+const (
+	recordTracesFile = "traces.json"
+	recordListFile   = "list.json"
+)
+
+// tracesRecorder is checked by ApmTraceClient so the sampled traces of each analysis are kept with the adapter records.
+type tracesRecorder interface {
+	RecordTraces(traces *model.Traces) error
+}
+
+type listRecord struct {
+	Params   *api.QueryParams      `json:"params"`
+	Response api.TraceListResponse `json:"response"`
+}
+
+type detailRecord struct {
+	Params   *api.QueryParams        `json:"params"`
+	Response api.TraceDetailResponse `json:"response"`
+}
+
+type tracesRecord struct {
+	TraceId string         `json:"traceId"`
+	Traces  []*model.Trace `json:"traces"`
+	Sent    []bool         `json:"sent"`
+}
+
+// RecordAdapter persists every adapter request and response under <dir>/<traceId>/.
+type RecordAdapter struct {
+	api api.AdapterAPI
+	dir string
+}
+
+func NewRecordAdapter(adapterApi api.AdapterAPI, dir string) (*RecordAdapter, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create record dir %s failed: %w", dir, err)
+	}
+	return &RecordAdapter{
+		api: adapterApi,
+		dir: dir,
+	}, nil
+}
+
+func (r *RecordAdapter) QueryList(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelServiceNode, error) {
+	serviceNodes, err := r.api.QueryList(ctx, params)
+	record := &listRecord{
+		Params: params,
+		Response: api.TraceListResponse{
+			Success: err == nil,
+			Data:    serviceNodes,
+		},
+	}
+	if err != nil {
+		record.Response.ErrorMsg = err.Error()
+	}
+	if writeErr := writeTraceRecord(r.dir, params.TraceId, recordListFile, record); writeErr != nil {
+		return serviceNodes, errors.Join(err, writeErr)
+	}
+	return serviceNodes, err
+}
+
+func (r *RecordAdapter) QueryDetail(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelSpan, error) {
+	spans, err := r.api.QueryDetail(ctx, params)
+	record := &detailRecord{
+		Params: params,
+		Response: api.TraceDetailResponse{
+			Success: err == nil,
+			Data:    spans,
+		},
+	}
+	if err != nil {
+		record.Response.ErrorMsg = err.Error()
+	}
+	if writeErr := writeTraceRecord(r.dir, params.TraceId, getDetailRecordFile(params), record); writeErr != nil {
+		return spans, errors.Join(err, writeErr)
+	}
+	return spans, err
+}
+
+func (r *RecordAdapter) RecordTraces(traces *model.Traces) error {
+	record := &tracesRecord{
+		TraceId: traces.TraceId,
+		Traces:  traces.Traces,
+		Sent:    make([]bool, 0, len(traces.Traces)),
+	}
+	for _, trace := range traces.Traces {
+		record.Sent = append(record.Sent, trace.IsSent)
+	}
+	return writeTraceRecord(r.dir, traces.TraceId, recordTracesFile, record)
+}
+
+// ReplayAdapter serves the records written by RecordAdapter without calling a live adapter.
+type ReplayAdapter struct {
+	dir string
+}
+
+func NewReplayAdapter(dir string) (*ReplayAdapter, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("record path %s is not a directory", dir)
+	}
+	return &ReplayAdapter{dir: dir}, nil
+}
+
+func (r *ReplayAdapter) QueryList(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelServiceNode, error) {
+	var record listRecord
+	if err := readTraceRecord(r.dir, params.TraceId, recordListFile, &record); err != nil {
+		return nil, err
+	}
+	if !record.Response.Success {
+		return nil, errors.New(record.Response.ErrorMsg)
+	}
+	return record.Response.Data, nil
+}
+
+func (r *ReplayAdapter) QueryDetail(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelSpan, error) {
+	var record detailRecord
+	if err := readTraceRecord(r.dir, params.TraceId, getDetailRecordFile(params), &record); err != nil {
+		return nil, err
+	}
+	if !record.Response.Success {
+		return nil, errors.New(record.Response.ErrorMsg)
+	}
+	return record.Response.Data, nil
+}
+
+func (r *ReplayAdapter) LoadTraces(traceId string) (*model.Traces, error) {
+	var record tracesRecord
+	if err := readTraceRecord(r.dir, traceId, recordTracesFile, &record); err != nil {
+		return nil, err
+	}
+	traces := model.NewTraces(record.TraceId)
+	for i, trace := range record.Traces {
+		if i < len(record.Sent) {
+			trace.IsSent = record.Sent[i]
+		}
+		traces.AddTrace(trace)
+	}
+	return traces, nil
+}
+
+func (r *ReplayAdapter) ListTraceIds() ([]string, error) {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return nil, err
+	}
+	traceIds := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(r.dir, entry.Name(), recordTracesFile)); err == nil {
+			traceIds = append(traceIds, entry.Name())
+		}
+	}
+	sort.Strings(traceIds)
+	return traceIds, nil
+}
+
+func getDetailRecordFile(params *api.QueryParams) string {
+	hash := fnv.New64a()
+	hash.Write([]byte(fmt.Sprintf("%s|%s|%d|%s", params.ApmType, params.ClusterID, params.StartTime, params.Attributes)))
+	return fmt.Sprintf("detail-%x.json", hash.Sum64())
+}
+
+// getTraceDir returns <dir>/<traceId>, traceId which is not a single path element is rejected.
+func getTraceDir(dir string, traceId string) (string, error) {
+	if traceId == "" || traceId == "." || traceId == ".." || strings.ContainsAny(traceId, `/\`) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidRecordDir, traceId)
+	}
+	return filepath.Join(dir, traceId), nil
+}
+
+func writeTraceRecord(dir string, traceId string, name string, record interface{}) error {
+	traceDir, err := getTraceDir(dir, traceId)
+	if err != nil {
+		return err
+	}
+	return writeRecord(traceDir, name, record)
+}
+
+func readTraceRecord(dir string, traceId string, name string, record interface{}) error {
+	traceDir, err := getTraceDir(dir, traceId)
+	if err != nil {
+		return err
+	}
+	return readRecord(traceDir, name, record)
+}
+
+func writeRecord(dir string, name string, record interface{}) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal record %s failed: %w", name, err)
+	}
+	// Write to a temp file first, so replay never sees a half written record.
+	tmpFile, err := os.CreateTemp(dir, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	if err = tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	return os.Rename(tmpFile.Name(), filepath.Join(dir, name))
+}
+
+func readRecord(dir string, name string, record interface{}) error {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%w: %s", ErrNotRecorded, filepath.Join(dir, name))
+		}
+		return err
+	}
+	if err = json.Unmarshal(data, record); err != nil {
+		return fmt.Errorf("decode record %s failed: %w", name, err)
+	}
+	return nil
+}
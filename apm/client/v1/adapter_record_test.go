@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+	apmmodel "github.com/CloudDetail/apo-module/apm/model/v1"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+type stubAdapter struct {
+	nodes []*apmmodel.OtelServiceNode
+	spans []*apmmodel.OtelSpan
+}
+
+func (s *stubAdapter) QueryList(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelServiceNode, error) {
+	return s.nodes, nil
+}
+
+func (s *stubAdapter) QueryDetail(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelSpan, error) {
+	if params.StartTime == 0 {
+		return nil, errors.New("detail is not found")
+	}
+	return s.spans, nil
+}
+
+func Test_recordAndReplay(t *testing.T) {
+	entrySpan := &apmmodel.OtelSpan{StartTime: 1000, Duration: 500, ServiceName: "a", Name: "/a", SpanId: "s1", Kind: apmmodel.SpanKindServer}
+	exitSpan := &apmmodel.OtelSpan{StartTime: 1100, Duration: 300, ServiceName: "a", Name: "GET", SpanId: "s2", PSpanId: "s1", Kind: apmmodel.SpanKindClient}
+	stub := &stubAdapter{
+		nodes: []*apmmodel.OtelServiceNode{{EntrySpans: []*apmmodel.OtelSpan{entrySpan}}},
+		spans: []*apmmodel.OtelSpan{exitSpan},
+	}
+
+	dir := t.TempDir()
+	recorder, err := NewRecordAdapter(stub, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	listParams := &api.QueryParams{TraceId: "t1", ApmType: "skywalking", StartTime: 1}
+	detailParams := &api.QueryParams{TraceId: "t1", ApmType: "skywalking", StartTime: 1, Attributes: "x"}
+	missParams := &api.QueryParams{TraceId: "t1", ApmType: "skywalking", StartTime: 0}
+	if _, err := recorder.QueryList(ctx, listParams); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recorder.QueryDetail(ctx, detailParams); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recorder.QueryDetail(ctx, missParams); err == nil {
+		t.Fatal("want error from adapter")
+	}
+	traces := model.NewTraces("t1")
+	traces.AddTrace(&model.Trace{Labels: &model.TraceLabels{TraceId: "t1", ApmSpanId: "s1", TopSpan: true}, IsSent: true})
+	if err := recorder.RecordTraces(traces); err != nil {
+		t.Fatal(err)
+	}
+
+	replayer, err := NewReplayAdapter(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes, err := replayer.QueryList(ctx, listParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(nodes, stub.nodes) {
+		t.Errorf("QueryList() = %v, want %v", nodes, stub.nodes)
+	}
+	spans, err := replayer.QueryDetail(ctx, detailParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(spans, stub.spans) {
+		t.Errorf("QueryDetail() = %v, want %v", spans, stub.spans)
+	}
+	if _, err := replayer.QueryDetail(ctx, missParams); err == nil || err.Error() != "detail is not found" {
+		t.Errorf("QueryDetail() error = %v, want recorded error", err)
+	}
+	if _, err := replayer.QueryDetail(ctx, &api.QueryParams{TraceId: "t1", StartTime: 2}); !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("QueryDetail() error = %v, want ErrNotRecorded", err)
+	}
+
+	replayTraces, err := replayer.LoadTraces("t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayTraces.RootTrace == nil || replayTraces.SentTraceCount != 1 {
+		t.Errorf("LoadTraces() = %s", replayTraces.ToString())
+	}
+	traceIds, err := replayer.ListTraceIds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(traceIds, []string{"t1"}) {
+		t.Errorf("ListTraceIds() = %v", traceIds)
+	}
+}
+
+func Test_recordInvalidTraceId(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := NewRecordAdapter(&stubAdapter{}, filepath.Join(dir, "records"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, traceId := range []string{"", ".", "..", "../escaped", `..\escaped`, "a/b"} {
+		if _, err := recorder.QueryList(context.Background(), &api.QueryParams{TraceId: traceId}); !errors.Is(err, ErrInvalidRecordDir) {
+			t.Errorf("QueryList(%q) error = %v, want ErrInvalidRecordDir", traceId, err)
+		}
+		if err := recorder.RecordTraces(model.NewTraces(traceId)); !errors.Is(err, ErrInvalidRecordDir) {
+			t.Errorf("RecordTraces(%q) error = %v, want ErrInvalidRecordDir", traceId, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "escaped")); !os.IsNotExist(err) {
+		t.Errorf("record is written outside record dir")
+	}
+
+	client := NewApmTraceClientByAPI(recorder, 10, "maxService", nil)
+	if _, _, err := client.QueryMutatedSlowTraceTree(context.Background(), "", "t1", model.NewTraces("t1")); err == nil {
+		t.Errorf("QueryMutatedSlowTraceTree() want error of no root trace")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "records", "t1")); !os.IsNotExist(err) {
+		t.Errorf("traces without root trace are recorded")
+	}
+}
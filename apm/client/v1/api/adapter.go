@@ -11,6 +11,16 @@ type AdapterAPI interface {
 	QueryDetail(ctx context.Context, params *QueryParams) ([]*model.OtelSpan, error)
 }
 
+// BatchAdapterAPI queries the service nodes of many traces in one request,
+// handler is called once for each trace as soon as its result is received,
+// traces which are not returned by adapter are not passed to handler.
+type BatchAdapterAPI interface {
+	AdapterAPI
+	QueryBatchList(ctx context.Context, params []*QueryParams, handler BatchListHandler) error
+}
+
+type BatchListHandler func(traceId string, serviceNodes []*model.OtelServiceNode, err error)
+
 type QueryParams struct {
 	TraceId    string `json:"traceId"`
 	ApmType    string `json:"apmType"`
@@ -19,12 +29,24 @@ type QueryParams struct {
 	ClusterID  string `json:"clusterId"`
 }
 
+type BatchQueryParams struct {
+	Queries []*QueryParams `json:"queries"`
+}
+
 type TraceListResponse struct {
 	Success  bool                     `json:"success"`
 	Data     []*model.OtelServiceNode `json:"data"`
 	ErrorMsg string                   `json:"errorMsg"`
 }
 
+// TraceBatchListResponse is one item of the batch list stream, items are sent one json object per trace.
+type TraceBatchListResponse struct {
+	TraceId  string                   `json:"traceId"`
+	Success  bool                     `json:"success"`
+	Data     []*model.OtelServiceNode `json:"data"`
+	ErrorMsg string                   `json:"errorMsg"`
+}
+
 type TraceDetailResponse struct {
 	Success  bool              `json:"success"`
 	Data     []*model.OtelSpan `json:"data"`
@@ -15,3 +15,27 @@ type ApmTraceAPI interface {
 	QueryErrorTraceTree(ctx context.Context, clusterID string, traceId string, traces *model.Traces) (*model.ErrorTreeNode, error)
 	NeedGetDetailSpan(ctx context.Context, apmType string) bool
 }
+
+// BatchApmTraceAPI queries and analyzes many traces with batch query of adapter.
+type BatchApmTraceAPI interface {
+	ApmTraceAPI
+	QueryTraces(ctx context.Context, clusterID string, rootTraces []*model.TraceLabels) []*TraceResult
+	AnalyzeTraces(ctx context.Context, clusterID string, tracesList []*model.Traces, concurrency int) []*TraceAnalysisResult
+}
+
+type TraceResult struct {
+	TraceId string
+	Trace   *apmmodel.OTelTrace
+	Err     error
+}
+
+type TraceAnalysisResult struct {
+	TraceId string
+
+	SlowTree    *model.TraceTreeNode
+	ClientCalls []*model.ApmClientCall
+	SlowErr     error
+
+	ErrorTree *model.ErrorTreeNode
+	ErrorErr  error
+}
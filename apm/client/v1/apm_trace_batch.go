@@ -0,0 +1,199 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+	apmmodel "github.com/CloudDetail/apo-module/apm/model/v1"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+const batchQuerySize = 50
+
+func (client *ApmTraceClient) QueryTraces(ctx context.Context, clusterID string, rootTraces []*model.TraceLabels) []*api.TraceResult {
+	results := make([]*api.TraceResult, len(rootTraces))
+	client.queryBatchList(ctx, clusterID, rootTraces, func(index int, serviceNodes []*apmmodel.OtelServiceNode, err error) {
+		rootTrace := rootTraces[index]
+		result := &api.TraceResult{TraceId: rootTrace.TraceId, Err: err}
+		if err == nil {
+			result.Trace, result.Err = buildApmTrace(rootTrace.ApmType, serviceNodes, rootTrace)
+		}
+		results[index] = result
+	})
+
+	// Traces missed in batch query are queried one by one.
+	for i, rootTrace := range rootTraces {
+		if results[i] == nil {
+			apmTrace, err := client.QueryTrace(ctx, clusterID, rootTrace.ApmType, rootTrace.TraceId, rootTrace)
+			results[i] = &api.TraceResult{TraceId: rootTrace.TraceId, Trace: apmTrace, Err: err}
+		}
+	}
+	return results
+}
+
+// AnalyzeTraces runs slow and error analysis for each traces with at most concurrency workers,
+// service nodes are prefetched by batch query and analysis starts as soon as a trace is received.
+func (client *ApmTraceClient) AnalyzeTraces(ctx context.Context, clusterID string, tracesList []*model.Traces, concurrency int) []*api.TraceAnalysisResult {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	results := make([]*api.TraceAnalysisResult, len(tracesList))
+	prefetched := newPrefetchedAdapter(client.api)
+	analyzer := *client
+	analyzer.api = prefetched
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				results[index] = analyzer.analyzeTraces(ctx, clusterID, tracesList[index])
+			}
+		}()
+	}
+
+	queued := make([]bool, len(tracesList))
+	rootTraces := make([]*model.TraceLabels, 0, len(tracesList))
+	indexes := make([]int, 0, len(tracesList))
+	for i, traces := range tracesList {
+		if traces.RootTrace == nil {
+			err := fmt.Errorf("trace[%s] has no root trace", traces.TraceId)
+			results[i] = &api.TraceAnalysisResult{TraceId: traces.TraceId, SlowErr: err, ErrorErr: err}
+			queued[i] = true
+			continue
+		}
+		rootTraces = append(rootTraces, traces.RootTrace.Labels)
+		indexes = append(indexes, i)
+	}
+
+	client.queryBatchList(ctx, clusterID, rootTraces, func(index int, serviceNodes []*apmmodel.OtelServiceNode, err error) {
+		prefetched.put(rootTraces[index].TraceId, serviceNodes, err)
+		queued[indexes[index]] = true
+		jobs <- indexes[index]
+	})
+	for i := range tracesList {
+		if !queued[i] {
+			jobs <- i
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+func (client *ApmTraceClient) analyzeTraces(ctx context.Context, clusterID string, traces *model.Traces) *api.TraceAnalysisResult {
+	result := &api.TraceAnalysisResult{TraceId: traces.TraceId}
+	if err := ctx.Err(); err != nil {
+		result.SlowErr = err
+		result.ErrorErr = err
+		return result
+	}
+
+	rootTrace := traces.RootTrace.Labels
+	if traces.HasSlow || rootTrace.IsSlow {
+		result.SlowTree, result.ClientCalls, result.SlowErr = client.QueryMutatedSlowTraceTree(ctx, clusterID, traces.TraceId, traces)
+	}
+	if traces.HasError || rootTrace.IsError {
+		result.ErrorTree, result.ErrorErr = client.QueryErrorTraceTree(ctx, clusterID, traces.TraceId, traces)
+	}
+	return result
+}
+
+// queryBatchList calls handler with the index of rootTraces for each trace received by batch query,
+// traces which are not received (adapter has no batch api, request failed or duplicated traceId) are skipped.
+func (client *ApmTraceClient) queryBatchList(ctx context.Context, clusterID string, rootTraces []*model.TraceLabels, handler func(index int, serviceNodes []*apmmodel.OtelServiceNode, err error)) {
+	batchApi, ok := client.api.(api.BatchAdapterAPI)
+	if !ok {
+		return
+	}
+
+	for start := 0; start < len(rootTraces); start += batchQuerySize {
+		end := start + batchQuerySize
+		if end > len(rootTraces) {
+			end = len(rootTraces)
+		}
+		params := make([]*api.QueryParams, 0, end-start)
+		traceIndexes := make(map[string]int, end-start)
+		for i := start; i < end; i++ {
+			rootTrace := rootTraces[i]
+			if _, exist := traceIndexes[rootTrace.TraceId]; exist {
+				continue
+			}
+			traceIndexes[rootTrace.TraceId] = i
+			params = append(params, &api.QueryParams{
+				TraceId:    rootTrace.TraceId,
+				ApmType:    rootTrace.ApmType,
+				StartTime:  rootTrace.StartTime / 1e6,
+				Attributes: rootTrace.Attributes,
+				ClusterID:  clusterID,
+			})
+		}
+
+		err := batchApi.QueryBatchList(ctx, params, func(traceId string, serviceNodes []*apmmodel.OtelServiceNode, err error) {
+			if index, exist := traceIndexes[traceId]; exist {
+				delete(traceIndexes, traceId)
+				handler(index, serviceNodes, err)
+			}
+		})
+		if err != nil {
+			log.Printf("[x Batch Query List] %d/%d traces are not received, query one by one: %v", len(traceIndexes), len(params), err)
+		}
+	}
+}
+
+// prefetchedAdapter serves QueryList from the batch query result, each call gets its own copy
+// since service nodes are modified during analysis.
+type prefetchedAdapter struct {
+	api.AdapterAPI
+
+	mutex   sync.RWMutex
+	results map[string][]byte
+}
+
+func newPrefetchedAdapter(adapterApi api.AdapterAPI) *prefetchedAdapter {
+	return &prefetchedAdapter{
+		AdapterAPI: adapterApi,
+		results:    make(map[string][]byte),
+	}
+}
+
+// put keeps the service nodes of trace, failed traces are not kept so QueryList falls back to adapter.
+func (p *prefetchedAdapter) put(traceId string, serviceNodes []*apmmodel.OtelServiceNode, err error) {
+	if err != nil || len(serviceNodes) == 0 {
+		return
+	}
+	data, err := json.Marshal(serviceNodes)
+	if err != nil {
+		return
+	}
+	p.mutex.Lock()
+	p.results[traceId] = data
+	p.mutex.Unlock()
+}
+
+func (p *prefetchedAdapter) QueryList(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelServiceNode, error) {
+	p.mutex.RLock()
+	data, exist := p.results[params.TraceId]
+	p.mutex.RUnlock()
+	if !exist {
+		return p.AdapterAPI.QueryList(ctx, params)
+	}
+	var serviceNodes []*apmmodel.OtelServiceNode
+	if err := json.Unmarshal(data, &serviceNodes); err != nil {
+		return nil, err
+	}
+	return serviceNodes, nil
+}
+
+func (p *prefetchedAdapter) RecordTraces(traces *model.Traces) error {
+	if recorder, ok := p.AdapterAPI.(tracesRecorder); ok {
+		return recorder.RecordTraces(traces)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+	apmmodel "github.com/CloudDetail/apo-module/apm/model/v1"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+type stubBatchAdapter struct {
+	stubAdapter
+
+	batchErrors map[string]error
+	missed      map[string]bool
+
+	mutex     sync.Mutex
+	listCalls []string
+}
+
+func (s *stubBatchAdapter) QueryList(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelServiceNode, error) {
+	s.mutex.Lock()
+	s.listCalls = append(s.listCalls, params.TraceId)
+	s.mutex.Unlock()
+	return newStubServiceNodes(), nil
+}
+
+func (s *stubBatchAdapter) QueryBatchList(ctx context.Context, params []*api.QueryParams, handler api.BatchListHandler) error {
+	for _, param := range params {
+		if s.missed[param.TraceId] {
+			continue
+		}
+		if err := s.batchErrors[param.TraceId]; err != nil {
+			handler(param.TraceId, nil, err)
+			continue
+		}
+		handler(param.TraceId, newStubServiceNodes(), nil)
+	}
+	return nil
+}
+
+func newStubServiceNodes() []*apmmodel.OtelServiceNode {
+	return []*apmmodel.OtelServiceNode{{
+		EntrySpans: []*apmmodel.OtelSpan{{StartTime: 1000, Duration: 500, ServiceName: "a", Name: "/a", SpanId: "s1", Kind: apmmodel.SpanKindServer}},
+	}}
+}
+
+func TestApmTraceClient_QueryTraces(t *testing.T) {
+	adapter := &stubBatchAdapter{
+		batchErrors: map[string]error{"t2": errors.New("apm is down")},
+		missed:      map[string]bool{"t3": true},
+	}
+	client := NewApmTraceClientByAPI(adapter, 10, "maxService", nil)
+	rootTraces := []*model.TraceLabels{{TraceId: "t1"}, {TraceId: "t2"}, {TraceId: "t3"}}
+	results := client.QueryTraces(context.Background(), "", rootTraces)
+	if results[0].Err != nil || results[0].Trace == nil {
+		t.Errorf("t1 = %v, want trace from batch query", results[0].Err)
+	}
+	if results[1].Err == nil {
+		t.Errorf("t2 want error of batch query")
+	}
+	if results[2].Err != nil || results[2].Trace == nil {
+		t.Errorf("t3 = %v, want trace from QueryList", results[2].Err)
+	}
+	if len(adapter.listCalls) != 1 || adapter.listCalls[0] != "t3" {
+		t.Errorf("QueryList calls = %v, want [t3]", adapter.listCalls)
+	}
+}
+
+func TestPrefetchedAdapter(t *testing.T) {
+	adapter := &stubBatchAdapter{}
+	prefetched := newPrefetchedAdapter(adapter)
+	prefetched.put("t1", newStubServiceNodes(), nil)
+	prefetched.put("t2", nil, errors.New("apm is down"))
+
+	ctx := context.Background()
+	first, err := prefetched.QueryList(ctx, &api.QueryParams{TraceId: "t1"})
+	if err != nil || len(first) != 1 {
+		t.Fatalf("QueryList(t1) = %v, %v", first, err)
+	}
+	second, _ := prefetched.QueryList(ctx, &api.QueryParams{TraceId: "t1"})
+	if first[0] == second[0] {
+		t.Errorf("QueryList(t1) returns shared service nodes")
+	}
+	// Failed and missed traces fall back to adapter.
+	for _, traceId := range []string{"t2", "t3"} {
+		if _, err := prefetched.QueryList(ctx, &api.QueryParams{TraceId: traceId}); err != nil {
+			t.Errorf("QueryList(%s) error = %v", traceId, err)
+		}
+	}
+	if len(adapter.listCalls) != 2 {
+		t.Errorf("QueryList calls = %v, want [t2 t3]", adapter.listCalls)
+	}
+}
+
+func TestAdapterHTTPClient_QueryBatchList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"traceId": "t1", "success": true, "data": [{"entrySpans": [{"spanId": "s1"}]}]}
+{"traceId": "t2", "success": false, "errorMsg": "apm is down"}
+{"traceId": "unknown", "success": true}
+`))
+	}))
+	defer server.Close()
+
+	client, err := NewAdapterHTTPClientWithConfig(&AdapterConfig{Address: server.URL, Timeout: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := []*api.QueryParams{{TraceId: "t1"}, {TraceId: "t2"}, {TraceId: "t3"}}
+	received := make(map[string]error)
+	err = client.QueryBatchList(context.Background(), params, func(traceId string, serviceNodes []*apmmodel.OtelServiceNode, err error) {
+		received[traceId] = err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received) != 2 || received["t1"] != nil || received["t2"] == nil {
+		t.Errorf("received = %v, want t1 and t2 only", received)
+	}
+}
+
+func TestApmTraceClient_AgentEventRegistry(t *testing.T) {
+	client := NewApmTraceClientByAPI(&stubBatchAdapter{}, 10, "maxService", nil)
+	registry := model.NewAgentEventRegistry(nil)
+	registry.AddEvent(&model.AgentEvent{Timestamp: 900, Name: model.AgentEventAttach, Pid: 10, Status: true,
+		Labels: map[string]string{model.AgentLabelNodeName: "n1"}})
+	client.SetAgentEventRegistry(registry)
+
+	traces := model.NewTraces("t1")
+	traces.AddTrace(&model.Trace{Labels: &model.TraceLabels{
+		TraceId: "t1", ApmType: "skywalking", ApmSpanId: "s1", TopSpan: true, ServiceName: "a", Url: "/a",
+		StartTime: 1000, Duration: 500, IsSlow: true, IsError: true, NodeName: "n1", Pid: 10,
+		ThresholdType: model.P90ThresholdType, ThresholdValue: 200, ThresholdMultiple: 1,
+	}})
+	result := client.analyzeTraces(context.Background(), "", traces)
+	if result.SlowErr != nil || result.ErrorErr != nil {
+		t.Fatalf("analyzeTraces() error = %v, %v", result.SlowErr, result.ErrorErr)
+	}
+	want := "profiling is off since"
+	if reason := result.SlowTree.NotProfiledReason; !strings.HasPrefix(reason, want) {
+		t.Errorf("slow tree NotProfiledReason = %q, want %q", reason, want)
+	}
+	if reason := result.ErrorTree.NotProfiledReason; !strings.HasPrefix(reason, want) {
+		t.Errorf("error tree NotProfiledReason = %q, want %q", reason, want)
+	}
+}
+
+func TestApmTraceClient_InstanceResolver(t *testing.T) {
+	client := NewApmTraceClientByAPI(&stubBatchAdapter{}, 10, "maxService", nil)
+	resolver := model.NewInstanceResolver()
+	client.SetInstanceResolver(resolver)
+
+	traces := model.NewTraces("t1")
+	traces.AddTrace(&model.Trace{Labels: &model.TraceLabels{
+		TraceId: "t1", ApmType: "skywalking", ApmSpanId: "s1", TopSpan: true, ServiceName: "a", Url: "/a",
+		StartTime: 1000, Duration: 500, IsSlow: true, NodeName: "n1", ContainerId: "docker://aaaaaaaaaaaa1111", Pid: 10,
+		ThresholdType: model.P90ThresholdType, ThresholdValue: 200, ThresholdMultiple: 1,
+	}})
+	result := client.analyzeTraces(context.Background(), "", traces)
+	if result.SlowErr != nil {
+		t.Fatal(result.SlowErr)
+	}
+	if key, _ := resolver.ResolveContainer("aaaaaaaaaaaa"); key == "" || key != result.SlowTree.InstanceKey {
+		t.Errorf("ResolveContainer() = %q, want instance key %q of slow tree", key, result.SlowTree.InstanceKey)
+	}
+}
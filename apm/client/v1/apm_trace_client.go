@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 
 	"github.com/CloudDetail/apo-module/apm/client/v1/api"
 	"github.com/CloudDetail/apo-module/model/v1"
@@ -11,17 +12,21 @@ import (
 	apmmodel "github.com/CloudDetail/apo-module/apm/model/v1"
 )
 
-var _ api.ApmTraceAPI = &ApmTraceClient{}
+var _ api.BatchApmTraceAPI = &ApmTraceClient{}
 
 var (
 	ErrUnknownApmType error = errors.New("no match apmType is found")
 )
 
 type ApmTraceClient struct {
-	api            api.AdapterAPI
-	muatedRatio    int
-	mutateNodeMode string
-	getDetailTypes []string
+	api               api.AdapterAPI
+	muatedRatio       int
+	mutateNodeMode    string
+	getDetailTypes    []string
+	callPatternConfig *CallPatternConfig
+	pqlApi            PQLApi
+	agentRegistry     *model.AgentEventRegistry
+	instanceResolver  *model.InstanceResolver
 }
 
 func NewApmTraceClient(address string, timeout int64, muatedRatio int, mutateNodeMode string, getDetailTypes []string) *ApmTraceClient {
@@ -33,6 +38,14 @@ func NewApmTraceClient(address string, timeout int64, muatedRatio int, mutateNod
 	}
 }
 
+func NewApmTraceClientWithConfig(cfg *AdapterConfig, muatedRatio int, mutateNodeMode string, getDetailTypes []string) (*ApmTraceClient, error) {
+	adapterClient, err := NewAdapterHTTPClientWithConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewApmTraceClientByAPI(adapterClient, muatedRatio, mutateNodeMode, getDetailTypes), nil
+}
+
 func NewApmTraceClientByAPI(api api.AdapterAPI, muatedRatio int, mutateNodeMode string, getDetailTypes []string) *ApmTraceClient {
 	return &ApmTraceClient{
 		api:            api,
@@ -42,6 +55,26 @@ func NewApmTraceClientByAPI(api api.AdapterAPI, muatedRatio int, mutateNodeMode
 	}
 }
 
+func (client *ApmTraceClient) SetCallPatternConfig(cfg *CallPatternConfig) {
+	client.callPatternConfig = cfg
+}
+
+// SetPQLApi enables querying P90 for the nodes whose threshold is missing, pql.PQLApi of slo sdk can be used.
+func (client *ApmTraceClient) SetPQLApi(pqlApi PQLApi) {
+	client.pqlApi = pqlApi
+}
+
+// SetInstanceResolver registers the instances of analyzed traces, so gc and logs of the mutated node
+// are queried by its stable instance key.
+func (client *ApmTraceClient) SetInstanceResolver(resolver *model.InstanceResolver) {
+	client.instanceResolver = resolver
+}
+
+// SetAgentEventRegistry explains why the traced nodes of analyzed trees are not profiled.
+func (client *ApmTraceClient) SetAgentEventRegistry(registry *model.AgentEventRegistry) {
+	client.agentRegistry = registry
+}
+
 func (client *ApmTraceClient) QueryServices(ctx context.Context, clusterID string, apmType string, traceId string, rootTrace *model.TraceLabels) ([]*apmmodel.OtelServiceNode, error) {
 	param := &api.QueryParams{
 		TraceId:    traceId,
@@ -66,6 +99,10 @@ func (client *ApmTraceClient) QueryTrace(ctx context.Context, clusterID string,
 	if err != nil {
 		return nil, err
 	}
+	return buildApmTrace(apmType, serviceNodes, rootTrace)
+}
+
+func buildApmTrace(apmType string, serviceNodes []*apmmodel.OtelServiceNode, rootTrace *model.TraceLabels) (*apmmodel.OTelTrace, error) {
 	apmTrace := apmmodel.NewOTelTrace(apmType)
 	for _, serviceNode := range serviceNodes {
 		apmTrace.AddServiceNode(serviceNode, nil)
@@ -140,8 +177,13 @@ func (client *ApmTraceClient) FillMutatedSpan(ctx context.Context, clusterID str
 }
 
 func (client *ApmTraceClient) QueryMutatedSlowTraceTree(ctx context.Context, clusterID string, traceId string, traces *model.Traces) (*model.TraceTreeNode, []*model.ApmClientCall, error) {
+	if traces.RootTrace == nil {
+		return nil, nil, fmt.Errorf("trace[%s] has no root trace", traceId)
+	}
+	client.recordTraces(traces)
+	client.resolveInstances(traces)
 	entryTrace := traces.RootTrace.Labels
-	if uint64(entryTrace.ThresholdValue) >= entryTrace.Duration {
+	if entryTrace.ThresholdType.GetThreshold(entryTrace.ThresholdValue) >= entryTrace.Duration {
 		return nil, nil, fmt.Errorf("entry service(%s) duration(%d) is less than threshold(%s(%s)=%f)",
 			entryTrace.ServiceName, entryTrace.Duration, entryTrace.ThresholdType, entryTrace.ThresholdRange,
 			entryTrace.ThresholdValue)
@@ -153,7 +195,7 @@ func (client *ApmTraceClient) QueryMutatedSlowTraceTree(ctx context.Context, clu
 		return nil, nil, err
 	}
 
-	apmTraceTree, err := BuildTopologyTree(apmTrace, traces)
+	apmTraceTree, err := BuildTopologyTreeWithPQL(apmTrace, traces, client.pqlApi)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -169,11 +211,20 @@ func (client *ApmTraceClient) QueryMutatedSlowTraceTree(ctx context.Context, clu
 		}
 	}
 
+	mutatedTrace.CallPatterns = AnalyzeCallPatterns(apmTrace.GetServiceNode(mutatedTrace.SpanId), client.callPatternConfig)
 	clientCalls := GetClientCalls(apmTrace, mutatedTrace.SpanId)
+	if client.agentRegistry != nil {
+		client.agentRegistry.ExplainTraceTree(apmTraceTree.Root)
+	}
 	return apmTraceTree.Root, clientCalls, nil
 }
 
 func (client *ApmTraceClient) QueryErrorTraceTree(ctx context.Context, clusterID string, traceId string, traces *model.Traces) (*model.ErrorTreeNode, error) {
+	if traces.RootTrace == nil {
+		return nil, fmt.Errorf("trace[%s] has no root trace", traceId)
+	}
+	client.recordTraces(traces)
+	client.resolveInstances(traces)
 	entryTrace := traces.RootTrace.Labels
 	apmTrace, err := client.QueryTrace(ctx, clusterID, entryTrace.ApmType, traceId, entryTrace)
 	if err != nil {
@@ -199,6 +250,9 @@ func (client *ApmTraceClient) QueryErrorTraceTree(ctx context.Context, clusterID
 	if _, err := apmErrorTree.GetRootCauseErrorNode(traceId); err != nil {
 		return nil, err
 	}
+	if client.agentRegistry != nil {
+		client.agentRegistry.ExplainErrorTree(apmErrorTree.Root)
+	}
 
 	return apmErrorTree.Root, nil
 }
@@ -214,3 +268,20 @@ func (client *ApmTraceClient) NeedGetDetailSpan(ctx context.Context, apmType str
 	}
 	return false
 }
+
+func (client *ApmTraceClient) resolveInstances(traces *model.Traces) {
+	if client.instanceResolver == nil {
+		return
+	}
+	for _, trace := range traces.Traces {
+		client.instanceResolver.Resolve(trace)
+	}
+}
+
+func (client *ApmTraceClient) recordTraces(traces *model.Traces) {
+	if recorder, ok := client.api.(tracesRecorder); ok {
+		if err := recorder.RecordTraces(traces); err != nil {
+			log.Printf("[x Record Traces] traceId: %s, error: %v", traces.TraceId, err)
+		}
+	}
+}
@@ -36,7 +36,7 @@ var CalcByMutatedSpan CalcMutatedNodeFn = func(tree *TraceTree, traceId string,
 	}
 
 	if profiledNode == nil {
-		return nil, fmt.Errorf("Instance(%s) is not mutated. Mutated[%d], Self: %d", node.Id, node.MutatedValue, node.SelfTime)
+		return nil, fmt.Errorf("Instance(%s) is not mutated. Mutated[%d], Self: %d", node.InstanceKey, node.MutatedValue, node.SelfTime)
 	}
 
 	var percent float64 = 0
@@ -44,7 +44,7 @@ var CalcByMutatedSpan CalcMutatedNodeFn = func(tree *TraceTree, traceId string,
 		percent = float64(profiledNode.SelfTime*100) / float64(duration)
 	}
 	return nil, fmt.Errorf("Instance(%s) selfTime(%sms) has not enough duration ratio(%s%%)",
-		profiledNode.Id,
+		profiledNode.InstanceKey,
 		strconv.FormatFloat(float64(profiledNode.SelfTime)/1000000, 'f', 2, 64),
 		strconv.FormatFloat(percent, 'f', 2, 64))
 }
@@ -68,8 +68,8 @@ var CalcByMutatedService CalcMutatedNodeFn = func(tree *TraceTree, traceId strin
 		if duration > 0 {
 			percent = float64(serviceEndPoint.SelfTime*100) / float64(duration)
 		}
-		return nil, fmt.Errorf("service(%s) selfTime(%sms) has not enough duration ratio(%s%%)",
-			serviceEndPoint.ServiceName,
+		return nil, fmt.Errorf("service(%s) Instance(%s) selfTime(%sms) has not enough duration ratio(%s%%)",
+			serviceEndPoint.ServiceName, serviceEndPoint.InstanceKey,
 			strconv.FormatFloat(float64(serviceEndPoint.SelfTime)/1000000, 'f', 2, 64),
 			strconv.FormatFloat(percent, 'f', 2, 64))
 	}
@@ -78,7 +78,7 @@ var CalcByMutatedService CalcMutatedNodeFn = func(tree *TraceTree, traceId strin
 	if node.MutatedValue > 0 {
 		return node, nil
 	}
-	return nil, fmt.Errorf("Instance(%s) URL(%s) is not mutated. Mutated[%d], Self: %d", node.Id, node.Url, node.MutatedValue, node.SelfTime)
+	return nil, fmt.Errorf("Instance(%s) URL(%s) is not mutated. Mutated[%d], Self: %d", node.InstanceKey, node.Url, node.MutatedValue, node.SelfTime)
 }
 
 var CalcByTop3MutatedService CalcMutatedNodeFn = func(tree *TraceTree, traceId string, ratioThreshold int) (*model.TraceTreeNode, error) {
@@ -105,8 +105,8 @@ var CalcByTop3MutatedService CalcMutatedNodeFn = func(tree *TraceTree, traceId s
 			if duration > 0 {
 				percent = float64(serviceEndPoint.SelfTime*100) / float64(duration)
 			}
-			log.Printf("The Top[%d] service(%s) selfTime(%sms) has not enough duration ratio(%s%%)",
-				i+1, serviceEndPoint.ServiceName,
+			log.Printf("The Top[%d] service(%s) Instance(%s) selfTime(%sms) has not enough duration ratio(%s%%)",
+				i+1, serviceEndPoint.ServiceName, serviceEndPoint.InstanceKey,
 				strconv.FormatFloat(float64(serviceEndPoint.SelfTime)/1000000, 'f', 2, 64),
 				strconv.FormatFloat(percent, 'f', 2, 64))
 			continue
@@ -122,6 +122,6 @@ var CalcByTop3MutatedService CalcMutatedNodeFn = func(tree *TraceTree, traceId s
 		return nil, fmt.Errorf("no Top3 service has enough duration ratio")
 	} else {
 		return nil, fmt.Errorf("top3 node [%s] has enough duration ratio but is not profiled",
-			profiledMutatedNode.Id)
+			profiledMutatedNode.InstanceKey)
 	}
 }
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+func TestCalcByMutatedService_InstanceKey(t *testing.T) {
+	newNode := func(spanId string, instanceKey string, totalTime uint64, p90 uint64) *model.TraceTreeNode {
+		return &model.TraceTreeNode{
+			ServiceName: "b", Url: "/b", InstanceKey: instanceKey, SpanId: spanId,
+			TotalTime: totalTime, P90: p90, IsTraced: true, IsProfiled: true,
+		}
+	}
+	root := &model.TraceTreeNode{ServiceName: "a", Url: "/a", InstanceKey: "pod:default/a", SpanId: "s1", TotalTime: 1000, P90: 2000, IsTraced: true}
+	// Pod b-1 restarts with new pid during the trace, its spans are counted together and exceed the single span of b-2.
+	children := []*model.TraceTreeNode{
+		newNode("s2", "pod:default/b-1", 300, 100),
+		newNode("s3", "pod:default/b-1", 300, 100),
+		newNode("s4", "pod:default/b-2", 350, 50),
+	}
+	tree := &TraceTree{Root: root, NodeMap: map[string]*model.TraceTreeNode{"s1": root}}
+	for _, child := range children {
+		root.AddChild(child)
+		tree.NodeMap[child.SpanId] = child
+	}
+
+	for _, calc := range []CalcMutatedNodeFn{CalcByMutatedService, CalcByTop3MutatedService} {
+		node, err := calc(tree, "t1", 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if node.InstanceKey != "pod:default/b-1" {
+			t.Errorf("mutated node = %s of %s, want node of pod:default/b-1", node.SpanId, node.InstanceKey)
+		}
+	}
+}
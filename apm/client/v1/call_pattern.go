@@ -0,0 +1,294 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	apmmodel "github.com/CloudDetail/apo-module/apm/model/v1"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+const maxPatternSpanIds = 10
+
+var (
+	uuidRegex     = regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`)
+	hexIdRegex    = regexp.MustCompile(`^[0-9a-fA-F]{8,}$`)
+	numberIdRegex = regexp.MustCompile(`^[0-9]+$`)
+	digitRegex    = regexp.MustCompile(`[0-9]`)
+)
+
+type CallPatternConfig struct {
+	// Same sql fingerprint called at least NPlusOneThreshold times.
+	NPlusOneThreshold int
+	// Same http / rpc / mq target called at least ChattyThreshold times.
+	ChattyThreshold int
+	// Failed call retried at least RetryThreshold times.
+	RetryThreshold int
+	// At least SequentialThreshold calls to different targets run one after another,
+	// and take at least SequentialRatio percent of entry duration.
+	SequentialThreshold int
+	SequentialRatio     int
+}
+
+func DefaultCallPatternConfig() *CallPatternConfig {
+	return &CallPatternConfig{
+		NPlusOneThreshold:   5,
+		ChattyThreshold:     5,
+		RetryThreshold:      3,
+		SequentialThreshold: 3,
+		SequentialRatio:     50,
+	}
+}
+
+type exitCall struct {
+	span    *apmmodel.OtelSpan
+	reqType string
+	target  string
+	isSQL   bool
+}
+
+func (call *exitCall) key() string {
+	return call.reqType + "|" + call.target
+}
+
+// AnalyzeCallPatterns groups exit spans of the service by normalized target and finds N+1 queries,
+// chatty calls, retry storms and sequential calls which could run in parallel.
+func AnalyzeCallPatterns(serviceNode *apmmodel.OtelServiceNode, cfg *CallPatternConfig) []*model.CallPattern {
+	patterns := make([]*model.CallPattern, 0)
+	if serviceNode == nil || len(serviceNode.ExitSpans) == 0 {
+		return patterns
+	}
+	if cfg == nil {
+		cfg = DefaultCallPatternConfig()
+	}
+
+	calls := collectExitCalls(serviceNode.ExitSpans)
+	groupKeys := make([]string, 0)
+	groups := make(map[string][]*exitCall)
+	for _, call := range calls {
+		key := call.key()
+		if _, exist := groups[key]; !exist {
+			groupKeys = append(groupKeys, key)
+		}
+		groups[key] = append(groups[key], call)
+	}
+
+	for _, key := range groupKeys {
+		group := groups[key]
+		if retry := findRetryStorm(group, cfg.RetryThreshold); retry != nil {
+			patterns = append(patterns, retry)
+			continue
+		}
+		if group[0].isSQL && len(group) >= cfg.NPlusOneThreshold {
+			pattern := newCallPattern(model.NPlusOneCallPattern, group)
+			pattern.Message = fmt.Sprintf("same query is executed %d times, consider to query in batch", len(group))
+			patterns = append(patterns, pattern)
+		} else if !group[0].isSQL && len(group) >= cfg.ChattyThreshold {
+			pattern := newCallPattern(model.ChattyCallPattern, group)
+			pattern.Message = fmt.Sprintf("%s is called %d times, consider to merge the requests", group[0].reqType, len(group))
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	var entryDuration uint64
+	if entrySpan := serviceNode.GetEntrySpan(); entrySpan != nil {
+		entryDuration = entrySpan.Duration
+	}
+	patterns = append(patterns, findSequentialCalls(calls, entryDuration, cfg)...)
+
+	sort.SliceStable(patterns, func(i, j int) bool {
+		return patterns[i].SavingTime > patterns[j].SavingTime
+	})
+	return patterns
+}
+
+func collectExitCalls(exitSpans []*apmmodel.OtelSpan) []*exitCall {
+	calls := make([]*exitCall, 0, len(exitSpans))
+	// Exit spans may be added twice by QueryList and FillMutatedSpan.
+	spanIds := make(map[string]bool, len(exitSpans))
+	for _, span := range exitSpans {
+		if span.SpanId != "" {
+			if spanIds[span.SpanId] {
+				continue
+			}
+			spanIds[span.SpanId] = true
+		}
+		call := &exitCall{span: span}
+		call.reqType, call.target, call.isSQL = NormalizeCallTarget(span)
+		calls = append(calls, call)
+	}
+	sort.SliceStable(calls, func(i, j int) bool {
+		return calls[i].span.StartTime < calls[j].span.StartTime
+	})
+	return calls
+}
+
+// NormalizeCallTarget returns the request type and target of exit span,
+// sql is fingerprinted and ids in url path are replaced by {id}.
+func NormalizeCallTarget(span *apmmodel.OtelSpan) (reqType string, target string, isSQL bool) {
+	if statement, exist := span.Attributes[apmmodel.AttributeDBStatement]; exist && len(statement) > 0 {
+		reqType = span.Attributes[apmmodel.AttributeDBSystem]
+		if reqType == "" {
+			reqType = "sql"
+		}
+		return reqType, SQLFingerprint(statement), true
+	}
+	if httpUrl := span.GetHttpDetail(); httpUrl != "" {
+		target = GetUrlTemplate(httpUrl)
+		if method := span.GetHttpMethod(); method != "" {
+			target = method + " " + target
+		}
+		return "http", target, false
+	}
+	if rpcSystem, exist := span.Attributes[apmmodel.AttributeRpcSystem]; exist {
+		return rpcSystem, span.GetRpcDetail(span.Name), false
+	}
+	if messageSystem, exist := span.Attributes[apmmodel.AttributeMessageSystem]; exist {
+		return messageSystem, span.GetMessageDestination(span.Name), false
+	}
+	return "unknown", fmt.Sprintf("%s@%s", span.Name, span.GetPeer("")), false
+}
+
+func GetUrlTemplate(rawUrl string) string {
+	path := rawUrl
+	prefix := ""
+	if parsedUrl, err := url.Parse(rawUrl); err == nil {
+		path = parsedUrl.Path
+		if parsedUrl.Host != "" {
+			prefix = parsedUrl.Scheme + "://" + parsedUrl.Host
+		}
+	} else if index := strings.IndexAny(path, "?#"); index >= 0 {
+		path = path[:index]
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if isIdSegment(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	return prefix + strings.Join(segments, "/")
+}
+
+func isIdSegment(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	if numberIdRegex.MatchString(segment) || uuidRegex.MatchString(segment) {
+		return true
+	}
+	if hexIdRegex.MatchString(segment) && digitRegex.MatchString(segment) {
+		return true
+	}
+	// Long token with digits, eg. order no.
+	return len(segment) >= 16 && digitRegex.MatchString(segment) && !strings.ContainsAny(segment, ".")
+}
+
+func findRetryStorm(group []*exitCall, threshold int) *model.CallPattern {
+	if threshold <= 1 {
+		return nil
+	}
+	var retries []*exitCall
+	var longest []*exitCall
+	for _, call := range group {
+		// Retry follows the failed call, retries are not concurrent.
+		if len(retries) > 0 && (!retries[len(retries)-1].span.IsError() || call.span.StartTime < retries[len(retries)-1].span.GetEndTime()) {
+			retries = nil
+		}
+		retries = append(retries, call)
+		if len(retries) > len(longest) {
+			longest = retries
+		}
+	}
+	if len(longest) < threshold {
+		return nil
+	}
+	pattern := newCallPattern(model.RetryStormCallPattern, longest)
+	// The last call may success, other calls are wasted.
+	pattern.SavingTime = pattern.TotalTime - longest[len(longest)-1].span.Duration
+	pattern.Message = fmt.Sprintf("failed call is retried %d times", len(longest)-1)
+	return pattern
+}
+
+func findSequentialCalls(calls []*exitCall, entryDuration uint64, cfg *CallPatternConfig) []*model.CallPattern {
+	patterns := make([]*model.CallPattern, 0)
+	if cfg.SequentialThreshold <= 1 || entryDuration == 0 {
+		return patterns
+	}
+
+	checkRun := func(run []*exitCall) {
+		if len(run) < cfg.SequentialThreshold {
+			return
+		}
+		targets := make(map[string]bool)
+		for _, call := range run {
+			targets[call.key()] = true
+		}
+		// Sequential calls to one target are reported as NPlusOne or ChattyCalls.
+		if len(targets) < 2 {
+			return
+		}
+		pattern := newCallPattern(model.SequentialCallPattern, run)
+		if pattern.TotalTime*100 < entryDuration*uint64(cfg.SequentialRatio) {
+			return
+		}
+		pattern.ReqType = "mixed"
+		pattern.Target = joinTargets(run)
+		pattern.Message = fmt.Sprintf("%d calls to %d targets run one after another, consider to call them in parallel", len(run), len(targets))
+		patterns = append(patterns, pattern)
+	}
+
+	var run []*exitCall
+	for _, call := range calls {
+		if len(run) > 0 && call.span.StartTime < run[len(run)-1].span.GetEndTime() {
+			checkRun(run)
+			run = nil
+		}
+		run = append(run, call)
+	}
+	checkRun(run)
+	return patterns
+}
+
+func newCallPattern(patternType model.CallPatternType, calls []*exitCall) *model.CallPattern {
+	pattern := &model.CallPattern{
+		Type:    patternType,
+		ReqType: calls[0].reqType,
+		Target:  calls[0].target,
+		Count:   len(calls),
+		SpanIds: make([]string, 0),
+	}
+	for _, call := range calls {
+		pattern.TotalTime += call.span.Duration
+		if call.span.Duration > pattern.MaxTime {
+			pattern.MaxTime = call.span.Duration
+		}
+		if call.span.IsError() {
+			pattern.ErrorCount++
+		}
+		if len(pattern.SpanIds) < maxPatternSpanIds {
+			pattern.SpanIds = append(pattern.SpanIds, call.span.SpanId)
+		}
+	}
+	// Batched or parallel calls take about the time of the slowest one.
+	pattern.SavingTime = pattern.TotalTime - pattern.MaxTime
+	return pattern
+}
+
+func joinTargets(calls []*exitCall) string {
+	targets := make([]string, 0)
+	exists := make(map[string]bool)
+	for _, call := range calls {
+		if !exists[call.target] {
+			exists[call.target] = true
+			targets = append(targets, call.target)
+		}
+	}
+	if len(targets) > 3 {
+		return fmt.Sprintf("%s and %d more", strings.Join(targets[:3], ", "), len(targets)-3)
+	}
+	return strings.Join(targets, ", ")
+}
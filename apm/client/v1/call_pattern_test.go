@@ -0,0 +1,143 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	apmmodel "github.com/CloudDetail/apo-module/apm/model/v1"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+func TestSQLFingerprint(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"numbers", "SELECT * FROM orders WHERE id = 12 AND price > 3.5", "select * from orders where id = ? and price > ?"},
+		{"strings", `select * from users where name = 'O''Brien' or name = 'a\'b'`, "select * from users where name = ? or name = ?"},
+		{"identifier with digits", "select col1 from t2 where x = 0x1F", "select col1 from t2 where x = ?"},
+		{"placeholders", "select * from users where id = $1 and name = ?", "select * from users where id = ? and name = ?"},
+		{"in list", "select * from users where id in (1, 2, 3)", "select * from users where id in (?+)"},
+		{"in list of strings", "select * from users where id in ('a','b')", "select * from users where id in (?+)"},
+		{"multi rows", "insert into t (a, b) values (1, 2), (3, 4)", "insert into t (a, b) values (?+)"},
+		{"double quoted identifier", `select * from "Users" where "id" = 1`, `select * from "Users" where "id" = ?`},
+		{"escaped double quote", `select * from "a""b"`, `select * from "a""b"`},
+		{"backquoted identifier", "select * from `Orders`", "select * from orders"},
+		{"line comments", "select 1 -- comment\nfrom dual # other", "select ? from dual"},
+		{"block comment", "select /* hint */ *   from\n\tdual", "select * from dual"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SQLFingerprint(tt.query); got != tt.want {
+				t.Errorf("SQLFingerprint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if SQLFingerprint(`select * from "users"`) == SQLFingerprint(`select * from "orders"`) {
+		t.Errorf("quoted tables have the same fingerprint")
+	}
+}
+
+func TestGetUrlTemplate(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"http://order:8080/order/123?x=1", "http://order:8080/order/{id}"},
+		{"/user/3f2b1c9a-0d4e-4b7f-9a6c-1e2d3c4b5a69/profile", "/user/{id}/profile"},
+		{"/v1/items/ab12cd34ef", "/v1/items/{id}"},
+		{"/static/app.js", "/static/app.js"},
+	}
+	for _, tt := range tests {
+		if got := GetUrlTemplate(tt.url); got != tt.want {
+			t.Errorf("GetUrlTemplate(%s) = %s, want %s", tt.url, got, tt.want)
+		}
+	}
+}
+
+func newSQLSpan(spanId string, startTime uint64, duration uint64, statement string, code apmmodel.OtelStatusCode) *apmmodel.OtelSpan {
+	return &apmmodel.OtelSpan{
+		SpanId: spanId, StartTime: startTime, Duration: duration, Kind: apmmodel.SpanKindClient, Code: code,
+		Attributes: map[string]string{apmmodel.AttributeDBStatement: statement, apmmodel.AttributeDBSystem: "postgresql"},
+	}
+}
+
+func newHttpSpan(spanId string, startTime uint64, duration uint64, url string, code apmmodel.OtelStatusCode) *apmmodel.OtelSpan {
+	return &apmmodel.OtelSpan{
+		SpanId: spanId, StartTime: startTime, Duration: duration, Kind: apmmodel.SpanKindClient, Code: code,
+		Attributes: map[string]string{apmmodel.AttributeHTTPURL: url, apmmodel.AttributeHttpMethod: "GET"},
+	}
+}
+
+func TestAnalyzeCallPatterns(t *testing.T) {
+	tests := []struct {
+		name      string
+		exitSpans func() []*apmmodel.OtelSpan
+		want      []model.CallPatternType
+	}{
+		{"n+1", func() []*apmmodel.OtelSpan {
+			spans := make([]*apmmodel.OtelSpan, 0)
+			for i := 0; i < 5; i++ {
+				spans = append(spans, newSQLSpan(fmt.Sprintf("s%d", i), uint64(i*10), 5, fmt.Sprintf("select * from items where id = %d", i), apmmodel.StatusCodeOk))
+			}
+			return spans
+		}, []model.CallPatternType{model.NPlusOneCallPattern}},
+		{"quoted tables are not n+1", func() []*apmmodel.OtelSpan {
+			spans := make([]*apmmodel.OtelSpan, 0)
+			for i := 0; i < 5; i++ {
+				spans = append(spans, newSQLSpan(fmt.Sprintf("s%d", i), uint64(i*10), 1, fmt.Sprintf(`select * from "table%c"`, 'a'+i), apmmodel.StatusCodeOk))
+			}
+			return spans
+		}, []model.CallPatternType{}},
+		{"chatty", func() []*apmmodel.OtelSpan {
+			spans := make([]*apmmodel.OtelSpan, 0)
+			for i := 0; i < 5; i++ {
+				spans = append(spans, newHttpSpan(fmt.Sprintf("s%d", i), uint64(i*10), 1, fmt.Sprintf("http://user/user/%d", i), apmmodel.StatusCodeOk))
+			}
+			return spans
+		}, []model.CallPatternType{model.ChattyCallPattern}},
+		{"retry", func() []*apmmodel.OtelSpan {
+			return []*apmmodel.OtelSpan{
+				newHttpSpan("s1", 0, 10, "http://stock/stock/1", apmmodel.StatusCodeError),
+				newHttpSpan("s2", 10, 10, "http://stock/stock/1", apmmodel.StatusCodeError),
+				newHttpSpan("s3", 20, 10, "http://stock/stock/1", apmmodel.StatusCodeOk),
+			}
+		}, []model.CallPatternType{model.RetryStormCallPattern}},
+		{"concurrent calls are not retried", func() []*apmmodel.OtelSpan {
+			return []*apmmodel.OtelSpan{
+				newHttpSpan("s1", 0, 10, "http://stock/stock/1", apmmodel.StatusCodeError),
+				newHttpSpan("s2", 5, 10, "http://stock/stock/1", apmmodel.StatusCodeError),
+				newHttpSpan("s3", 8, 10, "http://stock/stock/1", apmmodel.StatusCodeOk),
+			}
+		}, []model.CallPatternType{}},
+		{"sequential", func() []*apmmodel.OtelSpan {
+			return []*apmmodel.OtelSpan{
+				newHttpSpan("s1", 0, 30, "http://user/user", apmmodel.StatusCodeOk),
+				newHttpSpan("s2", 30, 30, "http://stock/stock", apmmodel.StatusCodeOk),
+				newSQLSpan("s3", 60, 30, "select 1", apmmodel.StatusCodeOk),
+			}
+		}, []model.CallPatternType{model.SequentialCallPattern}},
+		{"duplicated span ids", func() []*apmmodel.OtelSpan {
+			span := newSQLSpan("s1", 0, 5, "select * from items where id = 1", apmmodel.StatusCodeOk)
+			return []*apmmodel.OtelSpan{span, span, span, span, span}
+		}, []model.CallPatternType{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serviceNode := &apmmodel.OtelServiceNode{
+				EntrySpans: []*apmmodel.OtelSpan{{SpanId: "entry", Duration: 100, Kind: apmmodel.SpanKindServer}},
+				ExitSpans:  tt.exitSpans(),
+			}
+			patterns := AnalyzeCallPatterns(serviceNode, nil)
+			got := make([]model.CallPatternType, 0, len(patterns))
+			for _, pattern := range patterns {
+				got = append(got, pattern.Type)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("AnalyzeCallPatterns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
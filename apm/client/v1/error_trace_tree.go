@@ -81,6 +81,7 @@ func newApmErrorTreeNode(node *apmmodel.OtelServiceNode) *model.ErrorTreeNode {
 	entrySpan := node.GetEntrySpan()
 	return &model.ErrorTreeNode{
 		Id:             entrySpan.ServiceName,
+		InstanceKey:    model.GetServiceInstanceKey(entrySpan.ServiceName),
 		ServiceName:    entrySpan.ServiceName,
 		Url:            entrySpan.Name,
 		StartTime:      entrySpan.StartTime,
@@ -0,0 +1,127 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+	apmmodel "github.com/CloudDetail/apo-module/apm/model/v1"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+var _ api.AdapterAPI = &FileAdapter{}
+
+// FileAdapter serves the adapter responses saved as json files, eg. the files in a customer bundle.
+type FileAdapter struct {
+	list        *api.TraceListResponse
+	detailSpans []*apmmodel.OtelSpan
+}
+
+// NewFileAdapter loads TraceListResponse from listFile and TraceDetailResponse from detailFiles.
+func NewFileAdapter(listFile string, detailFiles ...string) (*FileAdapter, error) {
+	adapter := &FileAdapter{
+		list:        &api.TraceListResponse{},
+		detailSpans: make([]*apmmodel.OtelSpan, 0),
+	}
+	if err := readJsonFile(listFile, adapter.list); err != nil {
+		return nil, err
+	}
+	for _, detailFile := range detailFiles {
+		var detail api.TraceDetailResponse
+		if err := readJsonFile(detailFile, &detail); err != nil {
+			return nil, err
+		}
+		if !detail.Success {
+			return nil, fmt.Errorf("detail %s is not success: %s", detailFile, detail.ErrorMsg)
+		}
+		adapter.detailSpans = append(adapter.detailSpans, detail.Data...)
+	}
+	return adapter, nil
+}
+
+func (f *FileAdapter) QueryList(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelServiceNode, error) {
+	if !f.list.Success {
+		return nil, errors.New(f.list.ErrorMsg)
+	}
+	if len(f.list.Data) == 0 {
+		return nil, fmt.Errorf("[x Trace NotFound] traceId: %s", params.TraceId)
+	}
+	return f.list.Data, nil
+}
+
+// QueryDetail returns the detail spans of the service node which starts at params.StartTime,
+// spans are matched by service name and time range of the entry span.
+func (f *FileAdapter) QueryDetail(ctx context.Context, params *api.QueryParams) ([]*apmmodel.OtelSpan, error) {
+	entrySpan := findEntrySpan(f.list.Data, params.StartTime)
+	if entrySpan == nil {
+		return f.detailSpans, nil
+	}
+	spans := make([]*apmmodel.OtelSpan, 0)
+	for _, span := range f.detailSpans {
+		if span.ServiceName != "" && span.ServiceName != entrySpan.ServiceName {
+			continue
+		}
+		if span.StartTime < entrySpan.StartTime || span.StartTime > entrySpan.StartTime+entrySpan.Duration {
+			continue
+		}
+		spans = append(spans, span)
+	}
+	return spans, nil
+}
+
+// findEntrySpan finds the first entry span of service node by start time in ms.
+func findEntrySpan(serviceNodes []*apmmodel.OtelServiceNode, startTime uint64) *apmmodel.OtelSpan {
+	for _, serviceNode := range serviceNodes {
+		if len(serviceNode.EntrySpans) > 0 && serviceNode.GetStartTime()/1e6 == startTime {
+			return serviceNode.EntrySpans[0]
+		}
+		if span := findEntrySpan(serviceNode.Children, startTime); span != nil {
+			return span
+		}
+	}
+	return nil
+}
+
+// LoadTracesFile loads the sampled traces which are saved as json of model.Traces or as array of model.Trace.
+func LoadTracesFile(path string) (*model.Traces, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var saved model.Traces
+	if err := json.Unmarshal(data, &saved); err != nil {
+		if err := json.Unmarshal(data, &saved.Traces); err != nil {
+			return nil, fmt.Errorf("decode traces %s failed: %w", path, err)
+		}
+	}
+
+	// Rebuild traces so RootTrace and counters are consistent with the traces.
+	traces := model.NewTraces(saved.TraceId)
+	for _, trace := range saved.Traces {
+		if trace == nil || trace.Labels == nil {
+			continue
+		}
+		if traces.TraceId == "" {
+			traces.TraceId = trace.Labels.TraceId
+		}
+		traces.AddTrace(trace)
+	}
+	if traces.RootTrace == nil {
+		return nil, fmt.Errorf("traces %s has no top span trace", path)
+	}
+	return traces, nil
+}
+
+func readJsonFile(path string, value interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return fmt.Errorf("decode %s failed: %w", path, err)
+	}
+	return nil
+}
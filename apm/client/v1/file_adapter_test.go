@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+)
+
+func TestFileAdapter(t *testing.T) {
+	dir := t.TempDir()
+	listFile := writeTestFile(t, dir, "list.json", `{"success": true, "data": [{
+		"entrySpans": [{"startTime": 1000000000, "duration": 100000000, "serviceName": "gateway", "name": "/a", "spanId": "s1", "kind": 2}],
+		"children": [{"entrySpans": [{"startTime": 1010000000, "duration": 50000000, "serviceName": "order", "name": "/b", "spanId": "s3", "pSpanId": "s2", "kind": 2}]}]
+	}]}`)
+	detailFile := writeTestFile(t, dir, "detail.json", `{"success": true, "data": [
+		{"startTime": 1020000000, "duration": 1000000, "serviceName": "order", "name": "SELECT", "spanId": "d1", "kind": 3},
+		{"startTime": 1020000000, "duration": 1000000, "serviceName": "gateway", "name": "GET", "spanId": "d2", "kind": 3},
+		{"startTime": 1090000000, "duration": 1000000, "serviceName": "order", "name": "SELECT", "spanId": "d3", "kind": 3}
+	]}`)
+
+	adapter, err := NewFileAdapter(listFile, detailFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	nodes, err := adapter.QueryList(ctx, &api.QueryParams{TraceId: "t1"})
+	if err != nil || len(nodes) != 1 {
+		t.Fatalf("QueryList() = %v, %v", nodes, err)
+	}
+
+	tests := []struct {
+		name      string
+		startTime uint64
+		want      []string
+	}{
+		{"order", 1010, []string{"d1"}},
+		{"gateway", 1000, []string{"d2"}},
+		{"unknown node", 2000, []string{"d1", "d2", "d3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spans, err := adapter.QueryDetail(ctx, &api.QueryParams{TraceId: "t1", StartTime: tt.startTime})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]string, 0, len(spans))
+			for _, span := range spans {
+				got = append(got, span.SpanId)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("QueryDetail() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("QueryDetail() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+
+	failedFile := writeTestFile(t, dir, "failed.json", `{"success": false, "errorMsg": "trace is expired"}`)
+	failed, err := NewFileAdapter(failedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := failed.QueryList(ctx, &api.QueryParams{TraceId: "t1"}); err == nil || err.Error() != "trace is expired" {
+		t.Errorf("QueryList() error = %v, want saved error", err)
+	}
+}
+
+func TestLoadTracesFile(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name    string
+		content string
+		traceId string
+		wantErr bool
+	}{
+		{"traces", `{"TraceId": "t1", "Traces": [{"labels": {"trace_id": "t1", "apm_span_id": "s1", "top_span": true}}, {"labels": {"trace_id": "t1", "apm_span_id": "s3"}}]}`, "t1", false},
+		{"array", `[{"labels": {"trace_id": "t2", "apm_span_id": "s1", "top_span": true}}]`, "t2", false},
+		{"no top span", `[{"labels": {"trace_id": "t3", "apm_span_id": "s1"}}]`, "", true},
+		{"invalid", `{"TraceId": 1}`, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			traces, err := LoadTracesFile(writeTestFile(t, dir, tt.name+".json", tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadTracesFile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if traces.TraceId != tt.traceId || traces.RootTrace == nil || traces.RootTrace.Labels.ApmSpanId != "s1" {
+				t.Errorf("LoadTracesFile() = %s", traces.ToString())
+			}
+		})
+	}
+}
+
+func writeTestFile(t *testing.T, dir string, name string, content string) string {
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+const (
+	ServiceLatencyPercentilePQLTemplate = `histogram_quantile(%s,sum(increase(kindling_span_trace_duration_nanoseconds_bucket{svc_name="%s",content_key="%s"}[%s])) by (%s))`
+	ServiceLatencyAvgPQLTemplate        = `sum(increase(kindling_span_trace_duration_nanoseconds_sum{svc_name="%s",content_key="%s"}[%s]))/sum(increase(kindling_span_trace_duration_nanoseconds_count{svc_name="%s",content_key="%s"}[%s]))`
+)
+
+// PQLApi is the part of slo/sdk pql.PQLApi used to query P90, so pql.PQLApi can be passed directly.
+type PQLApi interface {
+	QueryMetric(endTime uint64, query string) (float64, error)
+	BucketLabelName() string
+}
+
+// FillMissingP90 queries P90 of the nodes whose threshold is not reported by kindling,
+// the threshold range of root is used as the query range.
+func (tree *TraceTree) FillMissingP90(pqlApi PQLApi) {
+	if pqlApi == nil || tree.Root == nil {
+		return
+	}
+	thresholdRange := tree.Root.ThresholdRange
+	startTSMill, duration := thresholdRange.GetRange(int64(tree.Root.StartTime))
+
+	// Same service and url may appear many times in one trace.
+	p90Cache := make(map[string]uint64)
+	// NodeMap is keyed by spanId which is empty for untraced nodes, so the tree is walked.
+	var fillNode func(node *model.TraceTreeNode)
+	fillNode = func(node *model.TraceTreeNode) {
+		for _, child := range node.Children {
+			fillNode(child)
+		}
+		// Kindling labels the metrics by content key (http path), not the span name shown as Url.
+		contentKey := tree.contentKeys[node]
+		if contentKey == "" {
+			contentKey = node.Url
+		}
+		if node.P90 > 0 || len(node.ServiceName) == 0 || len(contentKey) == 0 {
+			return
+		}
+		key := node.ServiceName + "@" + contentKey
+		p90, exist := p90Cache[key]
+		if !exist {
+			query := GetServiceLatencyPQL(model.P90ThresholdType, node.ServiceName, contentKey, duration, pqlApi.BucketLabelName())
+			value, err := pqlApi.QueryMetric(uint64(startTSMill)*1e6, query)
+			if err != nil {
+				log.Printf("[x Query P90] service: %s, url: %s, error: %v", node.ServiceName, contentKey, err)
+			}
+			if value > 0 {
+				p90 = uint64(value)
+			}
+			p90Cache[key] = p90
+		}
+		if p90 > 0 {
+			node.P90 = p90
+			node.P90Source = model.P90FromPQL
+		}
+	}
+	fillNode(tree.Root)
+}
+
+// GetServiceLatencyPQL returns the latency query of thresholdType, absolute threshold needs no query.
+func GetServiceLatencyPQL(thresholdType model.ThresholdType, serviceName string, contentKey string, duration string, bucketLabelName string) string {
+	serviceName = escapeLabelValue(serviceName)
+	contentKey = escapeLabelValue(contentKey)
+	if thresholdType.IsAverage() {
+		return fmt.Sprintf(ServiceLatencyAvgPQLTemplate, serviceName, contentKey, duration, serviceName, contentKey, duration)
+	}
+	if thresholdType.IsPercentile() {
+		return fmt.Sprintf(ServiceLatencyPercentilePQLTemplate, thresholdType.GetPercentileString(), serviceName, contentKey, duration, bucketLabelName)
+	}
+	return ""
+}
+
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	apmmodel "github.com/CloudDetail/apo-module/apm/model/v1"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+type fakePQLApi struct {
+	values  map[string]float64
+	queries []string
+}
+
+func (f *fakePQLApi) QueryMetric(endTime uint64, query string) (float64, error) {
+	f.queries = append(f.queries, query)
+	for contentKey, value := range f.values {
+		if strings.Contains(query, `content_key="`+contentKey+`"`) {
+			return value, nil
+		}
+	}
+	return 0, errors.New("no data")
+}
+
+func (f *fakePQLApi) BucketLabelName() string {
+	return "le"
+}
+
+func TestBuildTopologyTreeWithPQL(t *testing.T) {
+	newChild := func(spanId string, target string) *apmmodel.OtelServiceNode {
+		return &apmmodel.OtelServiceNode{EntrySpans: []*apmmodel.OtelSpan{{
+			StartTime: 1100, Duration: 100, ServiceName: "stock", Name: "GET /stock/{id}", SpanId: spanId, PSpanId: "s2", Kind: apmmodel.SpanKindServer,
+			Attributes: map[string]string{apmmodel.AttributeHttpTarget: target},
+		}}}
+	}
+	serviceNodes := []*apmmodel.OtelServiceNode{{
+		EntrySpans: []*apmmodel.OtelSpan{{StartTime: 1000, Duration: 500, ServiceName: "order", Name: "/order", SpanId: "s1", Kind: apmmodel.SpanKindServer}},
+		Children: []*apmmodel.OtelServiceNode{
+			newChild("s3", "/stock?id=1"),
+			newChild("s4", "/stock?id=2"),
+			{EntrySpans: []*apmmodel.OtelSpan{{StartTime: 1300, Duration: 100, ServiceName: "user", Name: "/user", SpanId: "s5", PSpanId: "s2", Kind: apmmodel.SpanKindServer}}},
+		},
+	}}
+	traces := model.NewTraces("t1")
+	traces.AddTrace(&model.Trace{Labels: &model.TraceLabels{
+		TraceId: "t1", ApmType: "skywalking", ApmSpanId: "s1", TopSpan: true, ServiceName: "order", Url: "/order",
+		StartTime: 1000, Duration: 500, ThresholdType: model.P90ThresholdType, ThresholdValue: 200, ThresholdMultiple: 1,
+	}})
+	apmTrace, err := buildApmTrace("skywalking", serviceNodes, traces.RootTrace.Labels)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pqlApi := &fakePQLApi{values: map[string]float64{"/stock": 80}}
+	tree, err := BuildTopologyTreeWithPQL(apmTrace, traces, pqlApi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Root.P90 != 200 || tree.Root.P90Source != model.P90FromThreshold {
+		t.Errorf("root p90 = %d from %s, want threshold of kindling", tree.Root.P90, tree.Root.P90Source)
+	}
+	if len(tree.Root.Children) != 3 {
+		t.Fatalf("children = %d, want 3", len(tree.Root.Children))
+	}
+	for _, node := range tree.Root.Children[:2] {
+		if node.Url != "GET /stock/{id}" || node.P90 != 80 || node.P90Source != model.P90FromPQL {
+			t.Errorf("node url = %s, p90 = %d from %s, want span name, 80 from pql", node.Url, node.P90, node.P90Source)
+		}
+	}
+	if node := tree.Root.Children[2]; node.P90 != 0 || node.P90Source != "" {
+		t.Errorf("node %s p90 = %d from %s, want no p90", node.Url, node.P90, node.P90Source)
+	}
+	// Same service and content key is queried once.
+	if len(pqlApi.queries) != 2 {
+		t.Errorf("queries = %v, want one query for stock and user", pqlApi.queries)
+	}
+	if !strings.Contains(pqlApi.queries[0], `svc_name="stock"`) && !strings.Contains(pqlApi.queries[1], `svc_name="stock"`) {
+		t.Errorf("queries = %v, want query of stock", pqlApi.queries)
+	}
+}
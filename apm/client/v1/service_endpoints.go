@@ -16,10 +16,10 @@ func newServiceEndPoints() *serviceEndPoints {
 	}
 }
 
-func (services *serviceEndPoints) getOrCreateService(serviceName string, url string) *serviceEndPoint {
+func (services *serviceEndPoints) getOrCreateService(serviceName string, url string, instanceKey string) *serviceEndPoint {
 	var service *serviceEndPoint
 	for _, serviceEndPoint := range services.services {
-		if serviceEndPoint.ServiceName == serviceName && serviceEndPoint.EndPoint == url {
+		if serviceEndPoint.ServiceName == serviceName && serviceEndPoint.EndPoint == url && serviceEndPoint.InstanceKey == instanceKey {
 			service = serviceEndPoint
 			break
 		}
@@ -28,6 +28,7 @@ func (services *serviceEndPoints) getOrCreateService(serviceName string, url str
 		service = &serviceEndPoint{
 			ServiceName: serviceName,
 			EndPoint:    url,
+			InstanceKey: instanceKey,
 		}
 		services.services = append(services.services, service)
 	}
@@ -39,7 +40,8 @@ func (services *serviceEndPoints) addMutateSpan(traceSpan *model.TraceTreeNode)
 		return
 	}
 
-	service := services.getOrCreateService(traceSpan.ServiceName, traceSpan.Url)
+	// Spans of the same instance are counted together, including spans before the instance restarts.
+	service := services.getOrCreateService(traceSpan.ServiceName, traceSpan.Url, traceSpan.InstanceKey)
 	service.SelfTime += traceSpan.SelfTime
 	service.MutatedValue += traceSpan.MutatedValue
 	service.Spans = append(service.Spans, traceSpan)
@@ -48,6 +50,7 @@ func (services *serviceEndPoints) addMutateSpan(traceSpan *model.TraceTreeNode)
 type serviceEndPoint struct {
 	ServiceName  string
 	EndPoint     string
+	InstanceKey  string
 	MutatedValue int64
 	SelfTime     uint64
 	Spans        []*model.TraceTreeNode
@@ -2,7 +2,9 @@ package client
 
 import (
 	"reflect"
+	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/xwb1989/sqlparser"
 )
@@ -133,3 +135,94 @@ func SQLParseOperationAndTableNEW(query string) (string, string) {
 	}
 	return "SELECT", tables[0]
 }
+
+var (
+	sqlValueListRegex = regexp.MustCompile(`\(\s*\?(\s*,\s*\?)*\s*\)`)
+	sqlMultiRowRegex  = regexp.MustCompile(`\(\?\+\)(\s*,\s*\(\?\+\))+`)
+)
+
+// SQLFingerprint replaces literals with ? and folds value lists, so statements which only differ by parameters are equal.
+// Single quoted text is string literal, double quoted and backquoted text is identifier.
+func SQLFingerprint(query string) string {
+	var text strings.Builder
+	runes := []rune(query)
+	lastSpace := true
+	for i := 0; i < len(runes); i++ {
+		ch := runes[i]
+		switch {
+		case ch == '\'':
+			// Quoted string, '' and \' are escaped quotes.
+			for i++; i < len(runes); i++ {
+				if runes[i] == '\\' {
+					i++
+				} else if runes[i] == ch {
+					if i+1 < len(runes) && runes[i+1] == ch {
+						i++
+					} else {
+						break
+					}
+				}
+			}
+			text.WriteRune('?')
+			lastSpace = false
+		case ch == '-' && i+1 < len(runes) && runes[i+1] == '-', ch == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case ch == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			for i += 2; i+1 < len(runes) && !(runes[i] == '*' && runes[i+1] == '/'); i++ {
+			}
+			i++
+		case unicode.IsSpace(ch):
+			if !lastSpace {
+				text.WriteRune(' ')
+				lastSpace = true
+			}
+		case ch == '$' && i+1 < len(runes) && unicode.IsDigit(runes[i+1]), unicode.IsDigit(ch) && !isIdentifierRune(runes, i-1):
+			// Numbers and postgres placeholders ($1).
+			for i+1 < len(runes) && (unicode.IsDigit(runes[i+1]) || runes[i+1] == '.' || runes[i+1] == 'x' || runes[i+1] == 'X' || isHexRune(runes[i+1])) {
+				i++
+			}
+			text.WriteRune('?')
+			lastSpace = false
+		case ch == '`':
+			// Quoted identifier
+			for i++; i < len(runes) && runes[i] != '`'; i++ {
+				text.WriteRune(unicode.ToLower(runes[i]))
+			}
+			lastSpace = false
+		case ch == '"':
+			// Quoted identifier of postgres and standard sql, case sensitive and "" is escaped quote.
+			text.WriteRune(ch)
+			for i++; i < len(runes); i++ {
+				text.WriteRune(runes[i])
+				if runes[i] == '"' {
+					if i+1 < len(runes) && runes[i+1] == '"' {
+						i++
+						text.WriteRune('"')
+					} else {
+						break
+					}
+				}
+			}
+			lastSpace = false
+		default:
+			text.WriteRune(unicode.ToLower(ch))
+			lastSpace = false
+		}
+	}
+	fingerprint := strings.TrimSpace(text.String())
+	fingerprint = sqlValueListRegex.ReplaceAllString(fingerprint, "(?+)")
+	return sqlMultiRowRegex.ReplaceAllString(fingerprint, "(?+)")
+}
+
+func isIdentifierRune(runes []rune, i int) bool {
+	if i < 0 {
+		return false
+	}
+	return unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '.' || runes[i] == '$'
+}
+
+func isHexRune(ch rune) bool {
+	return (ch >= 'a' && ch <= 'f') || (ch >= 'A' && ch <= 'F')
+}
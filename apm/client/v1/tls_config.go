@@ -0,0 +1,5 @@
+package client
+
+import "github.com/CloudDetail/apo-module/model/v1/tlsconfig"
+
+type TLSConfig = tlsconfig.Config
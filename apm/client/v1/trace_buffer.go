@@ -0,0 +1,300 @@
+package client
+
+import (
+	"context"
+	"hash/fnv"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+type TraceBufferConfig struct {
+	ShardCount int
+	// Wait for other traces after root trace is received.
+	WaitTime time.Duration
+	// Traces without root trace are expired after ExpireTime since first trace is received.
+	ExpireTime time.Duration
+	// Traces is ready once MaxTraces traces are received, later traces of same traceId are dropped.
+	MaxTraces int
+	// Traces received in DispatchedKeepTime after their traceId is dispatched are dropped.
+	DispatchedKeepTime time.Duration
+	CheckInterval      time.Duration
+	// Workers which run the callbacks.
+	Concurrency int
+}
+
+func DefaultTraceBufferConfig() *TraceBufferConfig {
+	return &TraceBufferConfig{
+		ShardCount:         16,
+		WaitTime:           5 * time.Second,
+		ExpireTime:         60 * time.Second,
+		MaxTraces:          1000,
+		DispatchedKeepTime: 60 * time.Second,
+		CheckInterval:      time.Second,
+		Concurrency:        4,
+	}
+}
+
+// TraceBufferHandler is called when traces is ready, OnSlowReady and OnErrorReady are both called for slow and error trace.
+type TraceBufferHandler struct {
+	// Called once for slow or error traces before OnSlowReady and OnErrorReady.
+	OnReady      func(ctx context.Context, traces *model.Traces)
+	OnSlowReady  func(ctx context.Context, traces *model.Traces)
+	OnErrorReady func(ctx context.Context, traces *model.Traces)
+	// Traces without root trace.
+	OnExpired func(ctx context.Context, traces *model.Traces)
+}
+
+// TraceBuffer groups the kindling traces by traceId and dispatches them when root trace is received and waited for WaitTime.
+type TraceBuffer struct {
+	cfg     *TraceBufferConfig
+	handler *TraceBufferHandler
+	shards  []*traceShard
+	now     func() time.Time
+
+	jobs     chan *traceJob
+	started  atomic.Bool
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	wg       sync.WaitGroup
+}
+
+type traceShard struct {
+	mutex   sync.Mutex
+	entries map[string]*bufferedTraces
+	// Dispatch time of traceIds, late traces of them are dropped instead of being expired without root.
+	dispatched map[string]time.Time
+}
+
+type bufferedTraces struct {
+	traces       *model.Traces
+	firstTime    time.Time
+	rootTime     time.Time
+	droppedCount int
+}
+
+type traceJob struct {
+	traces  *model.Traces
+	expired bool
+}
+
+func NewTraceBuffer(cfg *TraceBufferConfig, handler *TraceBufferHandler) *TraceBuffer {
+	if cfg == nil {
+		cfg = DefaultTraceBufferConfig()
+	}
+	if cfg.ShardCount <= 0 {
+		cfg.ShardCount = 1
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 1
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = time.Second
+	}
+	shards := make([]*traceShard, cfg.ShardCount)
+	for i := range shards {
+		shards[i] = &traceShard{
+			entries:    make(map[string]*bufferedTraces),
+			dispatched: make(map[string]time.Time),
+		}
+	}
+	return &TraceBuffer{
+		cfg:     cfg,
+		handler: handler,
+		shards:  shards,
+		now:     time.Now,
+		jobs:    make(chan *traceJob, cfg.Concurrency*2),
+		stopCh:  make(chan struct{}),
+	}
+}
+
+// NewTraceBufferWithClient analyzes ready traces by client, onResult is called once with slow and error result.
+func NewTraceBufferWithClient(cfg *TraceBufferConfig, client *ApmTraceClient, clusterID string, onResult func(result *api.TraceAnalysisResult)) *TraceBuffer {
+	return NewTraceBuffer(cfg, &TraceBufferHandler{
+		OnReady: func(ctx context.Context, traces *model.Traces) {
+			onResult(client.analyzeTraces(ctx, clusterID, traces))
+		},
+	})
+}
+
+// Start dispatches ready traces until ctx is done or Stop, buffered traces are flushed on both.
+// Traces flushed after ctx is done are dispatched with a context detached from ctx, so they are still analyzed.
+// Start of a started buffer does nothing.
+func (buffer *TraceBuffer) Start(ctx context.Context) {
+	if !buffer.started.CompareAndSwap(false, true) {
+		return
+	}
+	flushCtx := context.WithoutCancel(ctx)
+	for i := 0; i < buffer.cfg.Concurrency; i++ {
+		buffer.wg.Add(1)
+		go func() {
+			defer buffer.wg.Done()
+			for job := range buffer.jobs {
+				if ctx.Err() != nil {
+					buffer.dispatch(flushCtx, job)
+				} else {
+					buffer.dispatch(ctx, job)
+				}
+			}
+		}()
+	}
+
+	buffer.wg.Add(1)
+	go func() {
+		defer buffer.wg.Done()
+		ticker := time.NewTicker(buffer.cfg.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				buffer.check(buffer.now(), false)
+			case <-ctx.Done():
+				buffer.check(buffer.now(), true)
+				close(buffer.jobs)
+				return
+			case <-buffer.stopCh:
+				buffer.check(buffer.now(), true)
+				close(buffer.jobs)
+				return
+			}
+		}
+	}()
+}
+
+// Stop flushes all traces, traces without root trace are passed to OnExpired, and waits for callbacks to finish.
+// Callbacks are called in current goroutine if buffer is not started.
+func (buffer *TraceBuffer) Stop() {
+	buffer.stopOnce.Do(func() {
+		if !buffer.started.Load() {
+			for _, job := range buffer.collect(buffer.now(), true) {
+				buffer.dispatch(context.Background(), job)
+			}
+			return
+		}
+		close(buffer.stopCh)
+		buffer.wg.Wait()
+	})
+}
+
+func (buffer *TraceBuffer) Add(trace *model.Trace) {
+	if trace == nil || trace.Labels == nil || trace.Labels.TraceId == "" {
+		return
+	}
+	traceId := trace.Labels.TraceId
+	shard := buffer.getShard(traceId)
+	now := buffer.now()
+
+	shard.mutex.Lock()
+	if dispatchTime, dispatched := shard.dispatched[traceId]; dispatched && now.Sub(dispatchTime) < buffer.cfg.DispatchedKeepTime {
+		shard.mutex.Unlock()
+		return
+	}
+	entry, exist := shard.entries[traceId]
+	if !exist {
+		entry = &bufferedTraces{traces: model.NewTraces(traceId), firstTime: now}
+		shard.entries[traceId] = entry
+	}
+	if buffer.cfg.MaxTraces > 0 && entry.traces.GetTraceCount() >= buffer.cfg.MaxTraces {
+		entry.droppedCount++
+		shard.mutex.Unlock()
+		return
+	}
+	entry.traces.AddTrace(trace)
+	if trace.Labels.TopSpan && entry.rootTime.IsZero() {
+		entry.rootTime = now
+	}
+	shard.mutex.Unlock()
+}
+
+func (buffer *TraceBuffer) Len() int {
+	count := 0
+	for _, shard := range buffer.shards {
+		shard.mutex.Lock()
+		count += len(shard.entries)
+		shard.mutex.Unlock()
+	}
+	return count
+}
+
+func (buffer *TraceBuffer) getShard(traceId string) *traceShard {
+	hash := fnv.New32a()
+	hash.Write([]byte(traceId))
+	return buffer.shards[hash.Sum32()%uint32(len(buffer.shards))]
+}
+
+// check dispatches ready and expired traces, all traces are dispatched when flush.
+func (buffer *TraceBuffer) check(now time.Time, flush bool) {
+	for _, job := range buffer.collect(now, flush) {
+		buffer.jobs <- job
+	}
+}
+
+// collect removes ready and expired traces from shards and remembers the dispatched traceIds.
+func (buffer *TraceBuffer) collect(now time.Time, flush bool) []*traceJob {
+	jobs := make([]*traceJob, 0)
+	for _, shard := range buffer.shards {
+		shard.mutex.Lock()
+		for traceId, dispatchTime := range shard.dispatched {
+			if now.Sub(dispatchTime) >= buffer.cfg.DispatchedKeepTime {
+				delete(shard.dispatched, traceId)
+			}
+		}
+		for traceId, entry := range shard.entries {
+			if job := buffer.getJob(entry, now, flush); job != nil {
+				delete(shard.entries, traceId)
+				if !job.expired && buffer.cfg.DispatchedKeepTime > 0 {
+					shard.dispatched[traceId] = now
+				}
+				jobs = append(jobs, job)
+			}
+		}
+		shard.mutex.Unlock()
+	}
+	return jobs
+}
+
+func (buffer *TraceBuffer) getJob(entry *bufferedTraces, now time.Time, flush bool) *traceJob {
+	if entry.traces.RootTrace != nil {
+		full := buffer.cfg.MaxTraces > 0 && entry.traces.GetTraceCount() >= buffer.cfg.MaxTraces
+		if flush || full || now.Sub(entry.rootTime) >= buffer.cfg.WaitTime {
+			if entry.droppedCount > 0 {
+				log.Printf("[x Trace Buffer] traceId: %s, %d traces are dropped by max traces %d", entry.traces.TraceId, entry.droppedCount, buffer.cfg.MaxTraces)
+			}
+			return &traceJob{traces: entry.traces}
+		}
+		return nil
+	}
+	if flush || now.Sub(entry.firstTime) >= buffer.cfg.ExpireTime {
+		return &traceJob{traces: entry.traces, expired: true}
+	}
+	return nil
+}
+
+func (buffer *TraceBuffer) dispatch(ctx context.Context, job *traceJob) {
+	if buffer.handler == nil {
+		return
+	}
+	traces := job.traces
+	if job.expired {
+		if buffer.handler.OnExpired != nil {
+			buffer.handler.OnExpired(ctx, traces)
+		}
+		return
+	}
+	rootTrace := traces.RootTrace.Labels
+	isSlow := traces.HasSlow || rootTrace.IsSlow
+	isError := traces.HasError || rootTrace.IsError
+	if (isSlow || isError) && buffer.handler.OnReady != nil {
+		buffer.handler.OnReady(ctx, traces)
+	}
+	if isSlow && buffer.handler.OnSlowReady != nil {
+		buffer.handler.OnSlowReady(ctx, traces)
+	}
+	if isError && buffer.handler.OnErrorReady != nil {
+		buffer.handler.OnErrorReady(ctx, traces)
+	}
+}
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CloudDetail/apo-module/apm/client/v1/api"
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+func TestTraceBuffer(t *testing.T) {
+	now := time.Unix(1000, 0)
+	buffer := NewTraceBuffer(&TraceBufferConfig{
+		ShardCount:  4,
+		WaitTime:    5 * time.Second,
+		ExpireTime:  30 * time.Second,
+		MaxTraces:   3,
+		Concurrency: 4,
+	}, nil)
+	buffer.now = func() time.Time { return now }
+
+	newTrace := func(traceId string, topSpan bool) *model.Trace {
+		return &model.Trace{Labels: &model.TraceLabels{TraceId: traceId, TopSpan: topSpan, IsSlow: topSpan}}
+	}
+	buffer.Add(newTrace("waiting", true))
+	buffer.Add(newTrace("full", false))
+	buffer.Add(newTrace("full", true))
+	buffer.Add(newTrace("full", false))
+	buffer.Add(newTrace("full", false))
+	buffer.Add(newTrace("noRoot", false))
+
+	readJobs := func() map[string]*traceJob {
+		jobs := make(map[string]*traceJob)
+		for len(buffer.jobs) > 0 {
+			job := <-buffer.jobs
+			jobs[job.traces.TraceId] = job
+		}
+		return jobs
+	}
+
+	buffer.check(now, false)
+	jobs := readJobs()
+	if len(jobs) != 1 || jobs["full"] == nil || jobs["full"].traces.GetTraceCount() != 3 {
+		t.Fatalf("full traces should be ready, jobs: %v", jobs)
+	}
+
+	now = now.Add(5 * time.Second)
+	buffer.check(now, false)
+	if jobs = readJobs(); len(jobs) != 1 || jobs["waiting"] == nil || jobs["waiting"].expired {
+		t.Fatalf("waiting traces should be ready after WaitTime, jobs: %v", jobs)
+	}
+
+	now = now.Add(25 * time.Second)
+	buffer.check(now, false)
+	if jobs = readJobs(); len(jobs) != 1 || jobs["noRoot"] == nil || !jobs["noRoot"].expired {
+		t.Fatalf("traces without root should be expired, jobs: %v", jobs)
+	}
+	if buffer.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", buffer.Len())
+	}
+}
+
+func TestTraceBuffer_StartStop(t *testing.T) {
+	slowCh := make(chan string, 1)
+	buffer := NewTraceBuffer(&TraceBufferConfig{WaitTime: time.Hour, ExpireTime: time.Hour}, &TraceBufferHandler{
+		OnSlowReady: func(ctx context.Context, traces *model.Traces) {
+			slowCh <- traces.TraceId
+		},
+	})
+	buffer.Start(context.Background())
+	buffer.Add(&model.Trace{Labels: &model.TraceLabels{TraceId: "t1", TopSpan: true, IsSlow: true}})
+	buffer.Stop()
+	select {
+	case traceId := <-slowCh:
+		if traceId != "t1" {
+			t.Errorf("OnSlowReady() traceId = %s", traceId)
+		}
+	default:
+		t.Errorf("traces are not flushed by Stop()")
+	}
+}
+
+func TestTraceBuffer_StartTwice(t *testing.T) {
+	buffer := NewTraceBuffer(&TraceBufferConfig{WaitTime: time.Hour, ExpireTime: time.Hour}, nil)
+	buffer.Start(context.Background())
+	buffer.Start(context.Background())
+	// Jobs channel is closed once.
+	buffer.Stop()
+}
+
+func TestTraceBuffer_CancelFlush(t *testing.T) {
+	client := NewApmTraceClientByAPI(&stubBatchAdapter{}, 10, "maxService", nil)
+	resultCh := make(chan *api.TraceAnalysisResult, 1)
+	buffer := NewTraceBufferWithClient(&TraceBufferConfig{WaitTime: time.Hour, ExpireTime: time.Hour}, client, "", func(result *api.TraceAnalysisResult) {
+		resultCh <- result
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	buffer.Start(ctx)
+	buffer.Add(&model.Trace{Labels: &model.TraceLabels{
+		TraceId: "t1", ApmType: "skywalking", ApmSpanId: "s1", TopSpan: true, ServiceName: "a", Url: "/a",
+		StartTime: 1000, Duration: 500, IsSlow: true,
+		ThresholdType: model.P90ThresholdType, ThresholdValue: 200, ThresholdMultiple: 1,
+	}})
+	cancel()
+
+	select {
+	case result := <-resultCh:
+		if result.TraceId != "t1" || result.SlowErr != nil || result.SlowTree == nil {
+			t.Errorf("flushed result = %+v, want analyzed slow tree", result)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("traces are not flushed after ctx is cancelled")
+	}
+	buffer.Stop()
+}
+
+func TestTraceBuffer_StopWithoutStart(t *testing.T) {
+	readyCount, expiredCount := 0, 0
+	buffer := NewTraceBuffer(&TraceBufferConfig{WaitTime: time.Hour, ExpireTime: time.Hour}, &TraceBufferHandler{
+		OnReady: func(ctx context.Context, traces *model.Traces) {
+			readyCount++
+		},
+		OnExpired: func(ctx context.Context, traces *model.Traces) {
+			expiredCount++
+		},
+	})
+	// Slow and error trace is passed to OnReady once.
+	buffer.Add(&model.Trace{Labels: &model.TraceLabels{TraceId: "t1", TopSpan: true, IsSlow: true, IsError: true}})
+	buffer.Add(&model.Trace{Labels: &model.TraceLabels{TraceId: "t2"}})
+	buffer.Stop()
+	buffer.Stop()
+	if readyCount != 1 || expiredCount != 1 {
+		t.Errorf("readyCount = %d, expiredCount = %d, want 1 and 1", readyCount, expiredCount)
+	}
+}
+
+func TestTraceBuffer_DispatchedKeepTime(t *testing.T) {
+	now := time.Unix(1000, 0)
+	buffer := NewTraceBuffer(&TraceBufferConfig{
+		WaitTime:           5 * time.Second,
+		ExpireTime:         30 * time.Second,
+		DispatchedKeepTime: 60 * time.Second,
+		Concurrency:        1,
+	}, nil)
+	buffer.now = func() time.Time { return now }
+
+	buffer.Add(&model.Trace{Labels: &model.TraceLabels{TraceId: "t1", TopSpan: true, IsSlow: true}})
+	now = now.Add(5 * time.Second)
+	buffer.check(now, false)
+	if len(buffer.jobs) != 1 {
+		t.Fatalf("jobs = %d, want 1", len(buffer.jobs))
+	}
+	<-buffer.jobs
+
+	// Late trace of dispatched traceId is dropped instead of being expired without root.
+	buffer.Add(&model.Trace{Labels: &model.TraceLabels{TraceId: "t1"}})
+	if buffer.Len() != 0 {
+		t.Errorf("late trace is buffered")
+	}
+
+	now = now.Add(60 * time.Second)
+	buffer.check(now, false)
+	buffer.Add(&model.Trace{Labels: &model.TraceLabels{TraceId: "t1"}})
+	if buffer.Len() != 1 {
+		t.Errorf("trace is dropped after DispatchedKeepTime")
+	}
+}
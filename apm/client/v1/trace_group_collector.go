@@ -0,0 +1,152 @@
+package client
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/CloudDetail/apo-module/model/v1"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const OtherContentKey = "__other__"
+
+var traceGroupLabels = []string{"service", "content_key", "cluster", "is_slow", "is_error"}
+
+type TraceGroupCollectorConfig struct {
+	Namespace string
+	// Content keys of a service over the limit are labelled as OtherContentKey.
+	MaxContentKeysPerService int
+	// Buckets of duration in seconds.
+	DurationBuckets []float64
+}
+
+func DefaultTraceGroupCollectorConfig() *TraceGroupCollectorConfig {
+	return &TraceGroupCollectorConfig{
+		Namespace:                "apo",
+		MaxContentKeysPerService: 100,
+		DurationBuckets:          []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+	}
+}
+
+// TraceGroupCollector publishes TraceGroup as prometheus metrics.
+type TraceGroupCollector struct {
+	cfg *TraceGroupCollectorConfig
+
+	requests        *prometheus.CounterVec
+	duration        *prometheus.HistogramVec
+	groupMetrics    *prometheus.CounterVec
+	overflowedGroup *prometheus.CounterVec
+
+	mutex       sync.Mutex
+	contentKeys map[string]map[string]struct{} // <service, contentKeys>
+	totalTraces model.ToTalTraces
+}
+
+var _ prometheus.Collector = &TraceGroupCollector{}
+
+func NewTraceGroupCollector(cfg *TraceGroupCollectorConfig) *TraceGroupCollector {
+	if cfg == nil {
+		cfg = DefaultTraceGroupCollectorConfig()
+	}
+	if len(cfg.DurationBuckets) == 0 {
+		cfg.DurationBuckets = prometheus.DefBuckets
+	}
+	return &TraceGroupCollector{
+		cfg: cfg,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: cfg.Namespace,
+			Name:      "trace_requests_total",
+			Help:      "Requests of trace groups.",
+		}, traceGroupLabels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: cfg.Namespace,
+			Name:      "trace_duration_seconds",
+			Help:      "Duration of trace groups.",
+			Buckets:   cfg.DurationBuckets,
+		}, traceGroupLabels),
+		groupMetrics: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: cfg.Namespace,
+			Name:      "trace_group_metric_total",
+			Help:      "Sum of the metrics carried by trace groups.",
+		}, append(append([]string{}, traceGroupLabels...), "metric", "field")),
+		overflowedGroup: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: cfg.Namespace,
+			Name:      "trace_content_key_overflow_total",
+			Help:      "Trace groups labelled as " + OtherContentKey + " for too many content keys of service.",
+		}, []string{"service"}),
+		contentKeys: make(map[string]map[string]struct{}),
+	}
+}
+
+func (c *TraceGroupCollector) Add(group *model.TraceGroup) {
+	if group == nil || group.Labels == nil {
+		return
+	}
+	traceLabels := group.Labels
+	labels := []string{
+		traceLabels.ServiceName,
+		c.getContentKey(traceLabels.ServiceName, traceLabels.Url),
+		traceLabels.ClusterID,
+		strconv.FormatBool(traceLabels.IsSlow),
+		strconv.FormatBool(traceLabels.IsError),
+	}
+	c.requests.WithLabelValues(labels...).Inc()
+	c.duration.WithLabelValues(labels...).Observe(float64(traceLabels.Duration) / 1e9)
+	for _, metric := range group.Metrics {
+		for field, value := range metric.Data {
+			c.groupMetrics.WithLabelValues(append(labels, metric.Name, field)...).Add(float64(value))
+		}
+	}
+
+	c.mutex.Lock()
+	if traceLabels.IsSlow {
+		c.totalTraces.SlowTraces++
+	}
+	if traceLabels.IsError {
+		c.totalTraces.ErrorTraces++
+	}
+	c.mutex.Unlock()
+}
+
+// getContentKey returns OtherContentKey when service has MaxContentKeysPerService content keys.
+func (c *TraceGroupCollector) getContentKey(service string, contentKey string) string {
+	if c.cfg.MaxContentKeysPerService <= 0 {
+		return contentKey
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	keys, exist := c.contentKeys[service]
+	if !exist {
+		keys = make(map[string]struct{})
+		c.contentKeys[service] = keys
+	}
+	if _, found := keys[contentKey]; found {
+		return contentKey
+	}
+	if len(keys) >= c.cfg.MaxContentKeysPerService {
+		c.overflowedGroup.WithLabelValues(service).Inc()
+		return OtherContentKey
+	}
+	keys[contentKey] = struct{}{}
+	return contentKey
+}
+
+func (c *TraceGroupCollector) GetTotalTraces() model.ToTalTraces {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.totalTraces
+}
+
+func (c *TraceGroupCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.groupMetrics.Describe(ch)
+	c.overflowedGroup.Describe(ch)
+}
+
+func (c *TraceGroupCollector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.groupMetrics.Collect(ch)
+	c.overflowedGroup.Collect(ch)
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/CloudDetail/apo-module/model/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func newTestTraceGroup(service string, url string, isSlow bool, duration uint64) *model.TraceGroup {
+	return &model.TraceGroup{
+		Name: "single_net_request_metric_group",
+		Labels: &model.TraceLabels{
+			ServiceName: service,
+			Url:         url,
+			ClusterID:   "c1",
+			IsSlow:      isSlow,
+			Duration:    duration,
+		},
+		Metrics: []model.Metric{{Name: "request_total_time", Data: map[string]uint64{"Value": duration}}},
+	}
+}
+
+func TestTraceGroupCollector(t *testing.T) {
+	cfg := DefaultTraceGroupCollectorConfig()
+	cfg.MaxContentKeysPerService = 2
+	cfg.DurationBuckets = []float64{0.1, 1}
+	collector := NewTraceGroupCollector(cfg)
+	collector.Add(newTestTraceGroup("order", "GET /a", false, 50e6))
+	collector.Add(newTestTraceGroup("order", "GET /a", true, 500e6))
+	collector.Add(newTestTraceGroup("order", "GET /b", false, 50e6))
+	collector.Add(newTestTraceGroup("order", "GET /c", false, 50e6))
+	collector.Add(newTestTraceGroup("order", "GET /d", true, 2e9))
+	collector.Add(&model.TraceGroup{})
+
+	expected := `
+# HELP apo_trace_requests_total Requests of trace groups.
+# TYPE apo_trace_requests_total counter
+apo_trace_requests_total{cluster="c1",content_key="GET /a",is_error="false",is_slow="false",service="order"} 1
+apo_trace_requests_total{cluster="c1",content_key="GET /a",is_error="false",is_slow="true",service="order"} 1
+apo_trace_requests_total{cluster="c1",content_key="GET /b",is_error="false",is_slow="false",service="order"} 1
+apo_trace_requests_total{cluster="c1",content_key="__other__",is_error="false",is_slow="false",service="order"} 1
+apo_trace_requests_total{cluster="c1",content_key="__other__",is_error="false",is_slow="true",service="order"} 1
+# HELP apo_trace_content_key_overflow_total Trace groups labelled as __other__ for too many content keys of service.
+# TYPE apo_trace_content_key_overflow_total counter
+apo_trace_content_key_overflow_total{service="order"} 2
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected), "apo_trace_requests_total", "apo_trace_content_key_overflow_total"); err != nil {
+		t.Error(err)
+	}
+
+	if count := testutil.CollectAndCount(collector, "apo_trace_duration_seconds"); count != 5 {
+		t.Errorf("duration series = %d, want 5", count)
+	}
+	if count := testutil.CollectAndCount(collector, "apo_trace_group_metric_total"); count != 5 {
+		t.Errorf("group metric series = %d, want 5", count)
+	}
+	if total := collector.GetTotalTraces(); total.SlowTraces != 2 || total.ErrorTraces != 0 {
+		t.Errorf("GetTotalTraces() = %+v", total)
+	}
+}
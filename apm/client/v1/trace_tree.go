@@ -9,12 +9,16 @@ import (
 type TraceTree struct {
 	Root    *model.TraceTreeNode
 	NodeMap map[string]*model.TraceTreeNode
+
+	// Content key of entry span, used to query P90 of nodes not traced by kindling.
+	contentKeys map[*model.TraceTreeNode]string
 }
 
 func newTraceTree() *TraceTree {
 	return &TraceTree{
-		Root:    nil,
-		NodeMap: make(map[string]*model.TraceTreeNode, 0),
+		Root:        nil,
+		NodeMap:     make(map[string]*model.TraceTreeNode, 0),
+		contentKeys: make(map[*model.TraceTreeNode]string),
 	}
 }
 
@@ -28,8 +32,14 @@ func (tree *TraceTree) addTraceNode(parent *model.TraceTreeNode, child *model.Tr
 	return child
 }
 
+func (tree *TraceTree) addApmTraceNode(parent *model.TraceTreeNode, serviceNode *apmmodel.OtelServiceNode) *model.TraceTreeNode {
+	child := tree.addTraceNode(parent, newApmTraceTreeNode(serviceNode))
+	tree.contentKeys[child] = serviceNode.GetEntrySpan().GetContentKey()
+	return child
+}
+
 func (tree *TraceTree) collectApmTraceTree(node *apmmodel.OtelServiceNode, parentNode *model.TraceTreeNode, sampledNodeMap map[string]*model.Trace) {
-	currentNode := tree.addTraceNode(parentNode, newApmTraceTreeNode(node))
+	currentNode := tree.addApmTraceNode(parentNode, node)
 
 	if sampledTrace, exist := sampledNodeMap[node.SpanId]; exist {
 		currentNode.SetSampled(sampledTrace)
@@ -40,7 +50,7 @@ func (tree *TraceTree) collectApmTraceTree(node *apmmodel.OtelServiceNode, paren
 }
 
 func (tree *TraceTree) convertSlowTree(trace *NodeSpanTrace, parentNode *model.TraceTreeNode) {
-	currentNode := tree.addTraceNode(parentNode, newApmTraceTreeNode(trace.serviceNode))
+	currentNode := tree.addApmTraceNode(parentNode, trace.serviceNode)
 
 	if trace.SampledTrace != nil {
 		currentNode.SetSampled(trace.SampledTrace)
@@ -80,6 +90,7 @@ func newApmTraceTreeNode(node *apmmodel.OtelServiceNode) *model.TraceTreeNode {
 	entrySpan := node.GetEntrySpan()
 	return &model.TraceTreeNode{
 		Id:             entrySpan.ServiceName,
+		InstanceKey:    model.GetServiceInstanceKey(entrySpan.ServiceName),
 		ServiceName:    entrySpan.ServiceName,
 		Url:            entrySpan.Name,
 		StartTime:      entrySpan.StartTime,
@@ -23,6 +23,11 @@ func ConvertErrorTree(spanTrace *NodeSpanTrace) *ErrorTraceTree {
 }
 
 func BuildTopologyTree(trace *apmmodel.OTelTrace, sampledTraces *model.Traces) (*TraceTree, error) {
+	return BuildTopologyTreeWithPQL(trace, sampledTraces, nil)
+}
+
+// BuildTopologyTreeWithPQL fills P90 of nodes without kindling threshold by pqlApi, pqlApi is optional.
+func BuildTopologyTreeWithPQL(trace *apmmodel.OTelTrace, sampledTraces *model.Traces, pqlApi PQLApi) (*TraceTree, error) {
 	spanTraces := make(map[string]*model.Trace, 0)
 	mapSampleTraces(trace, sampledTraces)
 
@@ -49,6 +54,7 @@ func BuildTopologyTree(trace *apmmodel.OTelTrace, sampledTraces *model.Traces) (
 	if !traceTree.Root.IsTraced {
 		return nil, fmt.Errorf("entry[%s] is not collected by kindling", traceTree.Root.Id)
 	}
+	traceTree.FillMissingP90(pqlApi)
 	return traceTree, nil
 }
 
@@ -235,6 +241,9 @@ func convertErrorSpan(span *apmmodel.OtelSpan) *model.ErrorSpan {
 	for k, v := range span.Attributes {
 		apmErrorSpan.AddAttribute(k, v)
 	}
+	if span.TypedAttributes != nil {
+		apmErrorSpan.SetTypedAttributes(span.TypedAttributes.Clone())
+	}
 
 	apmErrorSpan.Exceptions = append(apmErrorSpan.Exceptions, span.Exceptions...)
 	return apmErrorSpan
@@ -4,7 +4,9 @@ const (
 	AttributeHTTPURL = "http.url" // 1.x
 	AttributeURLFULL = "url.full" // 2.x
 
-	AttributeHttpPath = "http.path"
+	AttributeHttpPath   = "http.path"
+	AttributeHttpTarget = "http.target" // 1.x
+	AttributeUrlPath    = "url.path"    // 2.x
 
 	AttributeHttpMethod        = "http.method"         // 1.x
 	AttributeHttpRequestMethod = "http.request.method" // 2.x
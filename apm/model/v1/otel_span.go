@@ -1,24 +1,29 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
 
 	cmodel "github.com/CloudDetail/apo-module/model/v1"
 )
 
 type OtelSpan struct {
-	StartTime   uint64              `json:"startTime"` // ns
-	Duration    uint64              `json:"duration"`  // ns
-	ServiceName string              `json:"serviceName,omitempty"`
-	Name        string              `json:"name"`
-	SpanId      string              `json:"spanId,omitempty"`
-	PSpanId     string              `json:"pSpanId,omitempty"`
-	NextSpanId  string              `json:"nextSpanId,omitempty"`
-	Kind        OtelSpanKind        `json:"kind"`
-	Code        OtelStatusCode      `json:"code"`
-	NotSampled  bool                `json:"-"`
-	Attributes  map[string]string   `json:"attributes,omitempty"`
-	Exceptions  []*cmodel.Exception `json:"exceptions,omitempty"`
+	StartTime   uint64            `json:"startTime"` // ns
+	Duration    uint64            `json:"duration"`  // ns
+	ServiceName string            `json:"serviceName,omitempty"`
+	Name        string            `json:"name"`
+	SpanId      string            `json:"spanId,omitempty"`
+	PSpanId     string            `json:"pSpanId,omitempty"`
+	NextSpanId  string            `json:"nextSpanId,omitempty"`
+	Kind        OtelSpanKind      `json:"kind"`
+	Code        OtelStatusCode    `json:"code"`
+	NotSampled  bool              `json:"-"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	// Attributes with original value types, Attributes keeps the string values for compatibility.
+	TypedAttributes *cmodel.AttributeMap `json:"typedAttributes,omitempty"`
+	Exceptions      []*cmodel.Exception  `json:"exceptions,omitempty"`
 }
 
 func NewOtelSpan() *OtelSpan {
@@ -27,6 +32,35 @@ func NewOtelSpan() *OtelSpan {
 	}
 }
 
+// UnmarshalJSON accepts attributes with non string values, they are kept in TypedAttributes.
+func (span *OtelSpan) UnmarshalJSON(data []byte) error {
+	type otelSpan OtelSpan
+	decoded := struct {
+		*otelSpan
+		Attributes *cmodel.AttributeMap `json:"attributes,omitempty"`
+	}{otelSpan: (*otelSpan)(span)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if decoded.Attributes == nil {
+		return nil
+	}
+	span.Attributes = decoded.Attributes.ToStringMap()
+	if span.TypedAttributes == nil && hasTypedValue(decoded.Attributes) {
+		span.TypedAttributes = decoded.Attributes
+	}
+	return nil
+}
+
+func hasTypedValue(attributes *cmodel.AttributeMap) bool {
+	typed := false
+	attributes.Range(func(key string, value cmodel.AttributeValue) bool {
+		typed = value.Type() != cmodel.StringAttributeValueType
+		return !typed
+	})
+	return typed
+}
+
 func (span *OtelSpan) SetStartTime(startTime uint64) {
 	span.StartTime = startTime
 }
@@ -65,6 +99,20 @@ func (span *OtelSpan) IsError() bool {
 
 func (span *OtelSpan) AddAttribute(key string, value string) {
 	span.Attributes[key] = value
+	if span.TypedAttributes != nil {
+		span.TypedAttributes.AddStringValue(key, value)
+	}
+}
+
+// SetTypedAttributes sets typed attributes and their string values to Attributes.
+func (span *OtelSpan) SetTypedAttributes(attributes *cmodel.AttributeMap) {
+	if span.Attributes == nil {
+		span.Attributes = make(map[string]string, attributes.Size())
+	}
+	span.TypedAttributes = attributes
+	for k, v := range attributes.ToStringMap() {
+		span.Attributes[k] = v
+	}
 }
 
 func (span *OtelSpan) AddException(timestamp uint64, name string, message string, stack string) {
@@ -109,6 +157,29 @@ func (span *OtelSpan) GetHttpDetail() string {
 	return span.Attributes[AttributeURLFULL]
 }
 
+// GetContentKey returns the content key of entry span as kindling reports it, kindling sees the request
+// of http server instead of the route in span name, so the path without query is used.
+func (span *OtelSpan) GetContentKey() string {
+	for _, key := range []string{AttributeHttpPath, AttributeUrlPath, AttributeHttpTarget} {
+		if path := span.Attributes[key]; path != "" {
+			return trimUrlQuery(path)
+		}
+	}
+	if httpUrl := span.GetHttpDetail(); httpUrl != "" {
+		if parsedUrl, err := url.Parse(httpUrl); err == nil && parsedUrl.Path != "" {
+			return parsedUrl.Path
+		}
+	}
+	return span.Name
+}
+
+func trimUrlQuery(path string) string {
+	if index := strings.IndexAny(path, "?#"); index >= 0 {
+		return path[:index]
+	}
+	return path
+}
+
 func (span *OtelSpan) GetPeer(defaultValue string) string {
 	// 1.x - redis、grpc、rabbitmq
 	if netSockPeerAddr, addrFound := span.Attributes[AttributeNetSockPeerAddr]; addrFound {
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOtelSpan_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantAttrs map[string]string
+		wantTyped bool
+	}{
+		{"string", `{"name":"GET","attributes":{"http.method":"GET"}}`, map[string]string{"http.method": "GET"}, false},
+		{"typed", `{"name":"GET","attributes":{"http.method":"GET","http.status_code":500}}`, map[string]string{"http.method": "GET", "http.status_code": "500"}, true},
+		{"none", `{"name":"GET"}`, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var span OtelSpan
+			if err := json.Unmarshal([]byte(tt.data), &span); err != nil {
+				t.Fatal(err)
+			}
+			if span.Name != "GET" || len(span.Attributes) != len(tt.wantAttrs) {
+				t.Fatalf("span = %+v", span)
+			}
+			for k, v := range tt.wantAttrs {
+				if span.Attributes[k] != v {
+					t.Errorf("Attributes[%s] = %s, want %s", k, span.Attributes[k], v)
+				}
+			}
+			if (span.TypedAttributes != nil) != tt.wantTyped {
+				t.Errorf("TypedAttributes = %v, want typed %v", span.TypedAttributes, tt.wantTyped)
+			}
+			if tt.wantTyped && span.TypedAttributes.GetIntValue("http.status_code") != 500 {
+				t.Errorf("TypedAttributes = %s", span.TypedAttributes.String())
+			}
+		})
+	}
+}
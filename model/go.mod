@@ -2,4 +2,9 @@ module github.com/CloudDetail/apo-module/model
 
 go 1.21
 
-require go.opentelemetry.io/collector/semconv v0.97.0
+require (
+	go.opentelemetry.io/collector/semconv v0.97.0
+	google.golang.org/protobuf v1.33.0
+)
+
+require github.com/google/go-cmp v0.6.0 // indirect
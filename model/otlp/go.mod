@@ -0,0 +1,23 @@
+module github.com/CloudDetail/apo-module/model/otlp
+
+go 1.21
+
+require (
+	github.com/CloudDetail/apo-module/model v0.0.0-00000000000000-000000000000
+	go.opentelemetry.io/collector/pdata v1.4.0
+)
+
+require (
+	github.com/gogo/protobuf v1.3.2 // indirect
+	github.com/golang/protobuf v1.5.3 // indirect
+	go.opentelemetry.io/collector/semconv v0.97.0 // indirect
+	go.uber.org/multierr v1.11.0 // indirect
+	golang.org/x/net v0.20.0 // indirect
+	golang.org/x/sys v0.16.0 // indirect
+	golang.org/x/text v0.14.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240123012728-ef4313101c80 // indirect
+	google.golang.org/grpc v1.62.1 // indirect
+	google.golang.org/protobuf v1.33.0 // indirect
+)
+
+replace github.com/CloudDetail/apo-module/model => ../
@@ -0,0 +1,123 @@
+// Package otlp converts the model to OpenTelemetry pdata, it is a separate module so model does not depend on pdata.
+package otlp
+
+import (
+	"github.com/CloudDetail/apo-module/model/v1"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// NewAttributeMap converts OpenTelemetry attributes, nested map and mixed slice are kept as json string.
+func NewAttributeMap(attributes pcommon.Map) *model.AttributeMap {
+	a := model.NewAttributeMap()
+	attributes.Range(func(key string, value pcommon.Value) bool {
+		switch value.Type() {
+		case pcommon.ValueTypeStr:
+			a.AddStringValue(key, value.Str())
+		case pcommon.ValueTypeInt:
+			a.AddIntValue(key, value.Int())
+		case pcommon.ValueTypeDouble:
+			a.AddDoubleValue(key, value.Double())
+		case pcommon.ValueTypeBool:
+			a.AddBoolValue(key, value.Bool())
+		case pcommon.ValueTypeBytes:
+			a.AddBytesValue(key, value.Bytes().AsRaw())
+		case pcommon.ValueTypeSlice:
+			if !addArrayValue(a, key, value.Slice()) {
+				a.AddStringValue(key, value.AsString())
+			}
+		case pcommon.ValueTypeMap:
+			a.AddStringValue(key, value.AsString())
+		}
+		return true
+	})
+	return a
+}
+
+// addArrayValue adds homogeneous slice as typed array, returns false if slice is mixed or nested.
+func addArrayValue(a *model.AttributeMap, key string, slice pcommon.Slice) bool {
+	if slice.Len() == 0 {
+		a.AddStringArrayValue(key, []string{})
+		return true
+	}
+	elemType := slice.At(0).Type()
+	for i := 1; i < slice.Len(); i++ {
+		if slice.At(i).Type() != elemType {
+			return false
+		}
+	}
+	switch elemType {
+	case pcommon.ValueTypeStr:
+		array := make([]string, slice.Len())
+		for i := range array {
+			array[i] = slice.At(i).Str()
+		}
+		a.AddStringArrayValue(key, array)
+	case pcommon.ValueTypeInt:
+		array := make([]int64, slice.Len())
+		for i := range array {
+			array[i] = slice.At(i).Int()
+		}
+		a.AddIntArrayValue(key, array)
+	case pcommon.ValueTypeDouble:
+		array := make([]float64, slice.Len())
+		for i := range array {
+			array[i] = slice.At(i).Double()
+		}
+		a.AddDoubleArrayValue(key, array)
+	case pcommon.ValueTypeBool:
+		array := make([]bool, slice.Len())
+		for i := range array {
+			array[i] = slice.At(i).Bool()
+		}
+		a.AddBoolArrayValue(key, array)
+	default:
+		return false
+	}
+	return true
+}
+
+// CopyAttributeMap puts all attributes to dest, existing keys are overwritten.
+func CopyAttributeMap(a *model.AttributeMap, dest pcommon.Map) {
+	dest.EnsureCapacity(dest.Len() + a.Size())
+	a.Range(func(key string, value model.AttributeValue) bool {
+		switch value.Type() {
+		case model.StringAttributeValueType:
+			dest.PutStr(key, a.GetStringValue(key))
+		case model.IntAttributeValueType:
+			dest.PutInt(key, a.GetIntValue(key))
+		case model.DoubleAttributeValueType:
+			dest.PutDouble(key, a.GetDoubleValue(key))
+		case model.BooleanAttributeValueType:
+			dest.PutBool(key, a.GetBoolValue(key))
+		case model.BytesAttributeValueType:
+			dest.PutEmptyBytes(key).FromRaw(a.GetBytesValue(key))
+		case model.StringArrayAttributeValueType:
+			slice := dest.PutEmptySlice(key)
+			for _, elem := range a.GetStringArrayValue(key) {
+				slice.AppendEmpty().SetStr(elem)
+			}
+		case model.IntArrayAttributeValueType:
+			slice := dest.PutEmptySlice(key)
+			for _, elem := range a.GetIntArrayValue(key) {
+				slice.AppendEmpty().SetInt(elem)
+			}
+		case model.DoubleArrayAttributeValueType:
+			slice := dest.PutEmptySlice(key)
+			for _, elem := range a.GetDoubleArrayValue(key) {
+				slice.AppendEmpty().SetDouble(elem)
+			}
+		case model.BooleanArrayAttributeValueType:
+			slice := dest.PutEmptySlice(key)
+			for _, elem := range a.GetBoolArrayValue(key) {
+				slice.AppendEmpty().SetBool(elem)
+			}
+		}
+		return true
+	})
+}
+
+func ToPcommonMap(a *model.AttributeMap) pcommon.Map {
+	dest := pcommon.NewMap()
+	CopyAttributeMap(a, dest)
+	return dest
+}
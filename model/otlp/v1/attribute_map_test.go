@@ -0,0 +1,38 @@
+package otlp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/CloudDetail/apo-module/model/v1"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestAttributeMap(t *testing.T) {
+	a := model.NewAttributeMap()
+	a.AddStringValue("str", "value")
+	a.AddIntValue("int", 42)
+	a.AddBoolValue("bool", true)
+	a.AddDoubleValue("double", 2)
+	a.AddBytesValue("bytes", []byte{1, 2, 3})
+	a.AddStringArrayValue("strs", []string{"a", "b"})
+	a.AddIntArrayValue("ints", []int64{1, 2})
+	a.AddDoubleArrayValue("doubles", []float64{1, 2.5})
+	a.AddBoolArrayValue("bools", []bool{true, false})
+
+	attributes := ToPcommonMap(a)
+	if attributes.Len() != a.Size() {
+		t.Fatalf("ToPcommonMap() = %v", attributes.AsRaw())
+	}
+	if got := NewAttributeMap(attributes); !reflect.DeepEqual(got, a) {
+		t.Errorf("NewAttributeMap() = %s, want %s", got.String(), a.String())
+	}
+
+	mixed := pcommon.NewMap()
+	slice := mixed.PutEmptySlice("mixed")
+	slice.AppendEmpty().SetInt(1)
+	slice.AppendEmpty().SetStr("a")
+	if got := NewAttributeMap(mixed); got.GetStringValue("mixed") != `[1,"a"]` {
+		t.Errorf("mixed slice = %s", got.String())
+	}
+}
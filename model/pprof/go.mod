@@ -0,0 +1,12 @@
+module github.com/CloudDetail/apo-module/model/pprof
+
+go 1.21
+
+replace github.com/CloudDetail/apo-module/model => ../
+
+require (
+	github.com/CloudDetail/apo-module/model v0.0.0-00000000000000-000000000000
+	github.com/google/pprof v0.0.0-20240424215950-a892ee059fd6
+)
+
+require go.opentelemetry.io/collector/semconv v0.97.0 // indirect
@@ -0,0 +1,74 @@
+// Package pprof exports the profiles of model as pprof, it is a separate module so model does not depend on pprof.
+package pprof
+
+import (
+	"io"
+
+	"github.com/CloudDetail/apo-module/model/v1"
+	"github.com/google/pprof/profile"
+)
+
+// BuildPprof builds a profile with on_cpu and off_cpu sample types, which can be opened by go tool pprof.
+func BuildPprof(samples []*model.StackSample, startTime uint64, endTime uint64) *profile.Profile {
+	p := &profile.Profile{
+		SampleType: []*profile.ValueType{
+			{Type: model.OnCpuSampleType, Unit: "nanoseconds"},
+			{Type: model.OffCpuSampleType, Unit: "nanoseconds"},
+		},
+		DefaultSampleType: model.OnCpuSampleType,
+		TimeNanos:         int64(startTime),
+		DurationNanos:     int64(endTime - startTime),
+	}
+	if endTime < startTime {
+		p.DurationNanos = 0
+	}
+
+	functions := make(map[string]*profile.Function)
+	locations := make(map[string]*profile.Location)
+	getLocation := func(frame string) *profile.Location {
+		if location, exist := locations[frame]; exist {
+			return location
+		}
+		function, exist := functions[frame]
+		if !exist {
+			function = &profile.Function{ID: uint64(len(p.Function) + 1), Name: frame, SystemName: frame}
+			functions[frame] = function
+			p.Function = append(p.Function, function)
+		}
+		location := &profile.Location{ID: uint64(len(p.Location) + 1), Line: []profile.Line{{Function: function}}}
+		locations[frame] = location
+		p.Location = append(p.Location, location)
+		return location
+	}
+
+	for _, sample := range samples {
+		// pprof locations are from leaf to root.
+		sampleLocations := make([]*profile.Location, 0, len(sample.Frames))
+		for i := len(sample.Frames) - 1; i >= 0; i-- {
+			sampleLocations = append(sampleLocations, getLocation(sample.Frames[i]))
+		}
+		p.Sample = append(p.Sample, &profile.Sample{
+			Location: sampleLocations,
+			Value:    []int64{int64(sample.OnCpuTime), int64(sample.OffCpuTime)},
+		})
+	}
+	return p
+}
+
+// WritePprof writes gzipped pprof protobuf of profiles, eg. the segments of model.RequestTimeline.GetProfiles.
+func WritePprof(w io.Writer, profilesList []*model.Profiles, parser model.StackParser) error {
+	var startTime, endTime uint64
+	for _, profiles := range profilesList {
+		if startTime == 0 || profiles.StartTime < startTime {
+			startTime = profiles.StartTime
+		}
+		if profiles.EndTime > endTime {
+			endTime = profiles.EndTime
+		}
+	}
+	p := BuildPprof(model.BuildStackSamples(profilesList, parser), startTime, endTime)
+	if err := p.CheckValid(); err != nil {
+		return err
+	}
+	return p.Write(w)
+}
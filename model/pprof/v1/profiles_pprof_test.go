@@ -0,0 +1,44 @@
+package pprof
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/CloudDetail/apo-module/model/v1"
+	"github.com/google/pprof/profile"
+)
+
+func TestWritePprof(t *testing.T) {
+	profiles := model.NewProfiles(1000, 2000)
+	profiles.Tid = 12
+	profiles.ThreadName = "http-nio"
+	profiles.CpuEvents = []*model.CpuEvent{{
+		StartTime:   1000,
+		EndTime:     2000,
+		TypeSpecs:   []uint64{400, 600},
+		TimeType:    []model.CPUType{model.CPUType_ON, model.CPUType_NET},
+		RunqLatency: []uint64{0},
+		Stack:       "main;handle;encode",
+		OffInfo:     "at socketRead\nat handle\nat main",
+	}}
+
+	var data bytes.Buffer
+	if err := WritePprof(&data, []*model.Profiles{profiles}, nil); err != nil {
+		t.Fatal(err)
+	}
+	p, err := profile.Parse(&data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Sample) != 2 || len(p.SampleType) != 2 {
+		t.Fatalf("pprof = %s", p.String())
+	}
+	onCpu := p.Sample[0]
+	if onCpu.Value[0] != 400 || onCpu.Location[0].Line[0].Function.Name != "encode" {
+		t.Errorf("on-cpu sample = %v", onCpu)
+	}
+	if !strings.Contains(p.String(), "socketRead") {
+		t.Errorf("off-cpu stack is missing")
+	}
+}
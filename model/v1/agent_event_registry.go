@@ -0,0 +1,326 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// Status: true is attached, false is detached.
+	AgentEventAttach = "attach"
+	// Status: true is profiling on, false is profiling off.
+	AgentEventProfiling = "profiling"
+	// Error of agent, message is in label AgentLabelError.
+	AgentEventError = "error"
+
+	// Same label names as CameraLogLabel which are reported by the agent.
+	AgentLabelNodeName    = "node_name"
+	AgentLabelContainerId = "container_id"
+	AgentLabelError       = "error"
+
+	DefaultAgentEventHistory = 100
+)
+
+// AgentEventConfig maps the event names and label names of AgentEvent, they must match the events sent by agent.
+type AgentEventConfig struct {
+	// Events kept for each process, older events are dropped.
+	MaxHistory     int
+	AttachEvent    string
+	ProfilingEvent string
+	ErrorEvent     string
+
+	NodeNameLabel    string
+	ContainerIdLabel string
+	ErrorLabel       string
+}
+
+func DefaultAgentEventConfig() *AgentEventConfig {
+	return &AgentEventConfig{
+		MaxHistory:       DefaultAgentEventHistory,
+		AttachEvent:      AgentEventAttach,
+		ProfilingEvent:   AgentEventProfiling,
+		ErrorEvent:       AgentEventError,
+		NodeNameLabel:    AgentLabelNodeName,
+		ContainerIdLabel: AgentLabelContainerId,
+		ErrorLabel:       AgentLabelError,
+	}
+}
+
+// AgentState is the state of agent on a process, UpdateTime is the time of last event.
+type AgentState struct {
+	Attached      bool   `json:"attached"`
+	Profiling     bool   `json:"profiling"`
+	LastError     string `json:"lastError,omitempty"`
+	LastErrorTime uint64 `json:"lastErrorTime,omitempty"`
+	// Time of the last change of Attached or Profiling, error events don't change it.
+	StateChangeTime uint64 `json:"stateChangeTime,omitempty"`
+	UpdateTime      uint64 `json:"updateTime"`
+}
+
+func (state *AgentState) apply(event *AgentEvent, cfg *AgentEventConfig) {
+	attached, profiling := state.Attached, state.Profiling
+	switch event.Name {
+	case cfg.AttachEvent:
+		state.Attached = event.Status
+		if !event.Status {
+			state.Profiling = false
+		}
+	case cfg.ProfilingEvent:
+		state.Profiling = event.Status
+		if event.Status {
+			state.Attached = true
+		}
+	case cfg.ErrorEvent:
+		state.LastError = event.Labels[cfg.ErrorLabel]
+		state.LastErrorTime = event.Timestamp
+	}
+	if state.Attached != attached || state.Profiling != profiling {
+		state.StateChangeTime = event.Timestamp
+	}
+	state.UpdateTime = event.Timestamp
+}
+
+type AgentProcess struct {
+	NodeName    string        `json:"nodeName"`
+	Pid         uint32        `json:"pid"`
+	ContainerId string        `json:"containerId"`
+	State       AgentState    `json:"state"`
+	History     []*AgentEvent `json:"history"`
+
+	// State before the first event of History.
+	baseState AgentState
+}
+
+// stateAt returns the state of agent at timestamp.
+func (process *AgentProcess) stateAt(timestamp uint64, cfg *AgentEventConfig) AgentState {
+	state := process.baseState
+	for _, event := range process.History {
+		if event.Timestamp > timestamp {
+			break
+		}
+		state.apply(event, cfg)
+	}
+	return state
+}
+
+// AgentEventRegistry tracks the agent state of each process by AgentEvent, events are ordered by timestamp
+// and at most MaxHistory events are kept for each process, the older events are dropped.
+// Process is keyed by node and pid, an attach after detach is taken as a new process reusing the pid.
+type AgentEventRegistry struct {
+	cfg *AgentEventConfig
+
+	mutex     sync.RWMutex
+	processes map[agentProcessKey]*AgentProcess
+}
+
+type agentProcessKey struct {
+	nodeName string
+	pid      uint32
+}
+
+func NewAgentEventRegistry(cfg *AgentEventConfig) *AgentEventRegistry {
+	if cfg == nil {
+		cfg = DefaultAgentEventConfig()
+	}
+	// Unset fields are default.
+	defaultCfg := DefaultAgentEventConfig()
+	if cfg.MaxHistory <= 0 {
+		cfg.MaxHistory = defaultCfg.MaxHistory
+	}
+	for _, field := range []struct{ value, defaultValue *string }{
+		{&cfg.AttachEvent, &defaultCfg.AttachEvent},
+		{&cfg.ProfilingEvent, &defaultCfg.ProfilingEvent},
+		{&cfg.ErrorEvent, &defaultCfg.ErrorEvent},
+		{&cfg.NodeNameLabel, &defaultCfg.NodeNameLabel},
+		{&cfg.ContainerIdLabel, &defaultCfg.ContainerIdLabel},
+		{&cfg.ErrorLabel, &defaultCfg.ErrorLabel},
+	} {
+		if *field.value == "" {
+			*field.value = *field.defaultValue
+		}
+	}
+	return &AgentEventRegistry{
+		cfg:       cfg,
+		processes: make(map[agentProcessKey]*AgentProcess),
+	}
+}
+
+func (registry *AgentEventRegistry) AddEvent(event *AgentEvent) {
+	if event == nil {
+		return
+	}
+	nodeName := event.Labels[registry.cfg.NodeNameLabel]
+	key := agentProcessKey{nodeName: nodeName, pid: event.Pid}
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	process, exist := registry.processes[key]
+	if exist && registry.isRestarted(process, event) {
+		exist = false
+	}
+	if !exist {
+		process = &AgentProcess{
+			NodeName: nodeName,
+			Pid:      event.Pid,
+			History:  make([]*AgentEvent, 0),
+		}
+		registry.processes[key] = process
+	}
+	if containerId := event.Labels[registry.cfg.ContainerIdLabel]; containerId != "" {
+		process.ContainerId = containerId
+	}
+
+	index := sort.Search(len(process.History), func(i int) bool {
+		return process.History[i].Timestamp > event.Timestamp
+	})
+	if index == 0 && len(process.History) >= registry.cfg.MaxHistory {
+		// Older than all kept events.
+		return
+	}
+	process.History = append(process.History, nil)
+	copy(process.History[index+1:], process.History[index:])
+	process.History[index] = event
+	for len(process.History) > registry.cfg.MaxHistory {
+		process.baseState.apply(process.History[0], registry.cfg)
+		process.History = process.History[1:]
+	}
+	process.State = process.stateAt(process.History[len(process.History)-1].Timestamp, registry.cfg)
+}
+
+// isRestarted checks whether event attaches to a new process which reuses the pid of detached process.
+func (registry *AgentEventRegistry) isRestarted(process *AgentProcess, event *AgentEvent) bool {
+	if event.Name != registry.cfg.AttachEvent || !event.Status || process.State.Attached || len(process.History) == 0 {
+		return false
+	}
+	if event.Timestamp <= process.History[len(process.History)-1].Timestamp {
+		return false
+	}
+	for i := len(process.History) - 1; i >= 0; i-- {
+		if process.History[i].Name == registry.cfg.AttachEvent {
+			return !process.History[i].Status
+		}
+	}
+	return false
+}
+
+// GetProcess returns a copy of agent process.
+func (registry *AgentEventRegistry) GetProcess(nodeName string, pid uint32) (*AgentProcess, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	process, exist := registry.processes[agentProcessKey{nodeName: nodeName, pid: pid}]
+	if !exist {
+		return nil, false
+	}
+	result := *process
+	result.History = append(make([]*AgentEvent, 0, len(process.History)), process.History...)
+	return &result, true
+}
+
+func (registry *AgentEventRegistry) GetStateAt(nodeName string, pid uint32, timestamp uint64) (AgentState, bool) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	process, exist := registry.processes[agentProcessKey{nodeName: nodeName, pid: pid}]
+	if !exist {
+		return AgentState{}, false
+	}
+	return process.stateAt(timestamp, registry.cfg), true
+}
+
+// IsProfilingActive checks whether profiling is on during [startTime, endTime].
+func (registry *AgentEventRegistry) IsProfilingActive(nodeName string, pid uint32, startTime uint64, endTime uint64) bool {
+	return registry.ExplainNotProfiled(nodeName, pid, startTime, endTime) == ""
+}
+
+// ExplainNotProfiled returns why the process is not profiled during [startTime, endTime], empty if profiling is on.
+func (registry *AgentEventRegistry) ExplainNotProfiled(nodeName string, pid uint32, startTime uint64, endTime uint64) string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	process, exist := registry.processes[agentProcessKey{nodeName: nodeName, pid: pid}]
+	if !exist {
+		return fmt.Sprintf("no agent event is received for pid %d on node %s", pid, nodeName)
+	}
+
+	state := process.stateAt(startTime, registry.cfg)
+	if !state.Attached {
+		return appendAgentError(fmt.Sprintf("agent is not attached to pid %d", pid), &state)
+	}
+	if !state.Profiling {
+		return appendAgentError(fmt.Sprintf("profiling is off since %s", formatAgentEventTime(state.StateChangeTime)), &state)
+	}
+	for _, event := range process.History {
+		if event.Timestamp <= startTime {
+			continue
+		}
+		if event.Timestamp > endTime {
+			break
+		}
+		state.apply(event, registry.cfg)
+		if !state.Attached {
+			return appendAgentError(fmt.Sprintf("agent is detached at %s during the trace", formatAgentEventTime(event.Timestamp)), &state)
+		}
+		if !state.Profiling {
+			return appendAgentError(fmt.Sprintf("profiling is turned off at %s during the trace", formatAgentEventTime(event.Timestamp)), &state)
+		}
+	}
+	return ""
+}
+
+// ExplainTraceTree sets NotProfiledReason for the traced nodes which are not profiled.
+func (registry *AgentEventRegistry) ExplainTraceTree(node *TraceTreeNode) {
+	if node.IsTraced && !node.IsProfiled {
+		node.NotProfiledReason = registry.explainNode(node.NodeName, node.Pid, node.StartTime, node.StartTime+node.TotalTime)
+	}
+	for _, child := range node.Children {
+		registry.ExplainTraceTree(child)
+	}
+}
+
+// ExplainErrorTree sets NotProfiledReason for the traced nodes which are not profiled.
+func (registry *AgentEventRegistry) ExplainErrorTree(node *ErrorTreeNode) {
+	if node.IsTraced && !node.IsProfiled {
+		node.NotProfiledReason = registry.explainNode(node.NodeName, node.Pid, node.StartTime, node.StartTime+node.TotalTime)
+	}
+	for _, child := range node.Children {
+		registry.ExplainErrorTree(child)
+	}
+}
+
+func (registry *AgentEventRegistry) explainNode(nodeName string, pid uint32, startTime uint64, endTime uint64) string {
+	if reason := registry.ExplainNotProfiled(nodeName, pid, startTime, endTime); reason != "" {
+		return reason
+	}
+	return "profiling is on but no profiling data is received"
+}
+
+// RemoveDetached removes the detached processes which have no event since expireTime.
+func (registry *AgentEventRegistry) RemoveDetached(expireTime uint64) int {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	removed := 0
+	for key, process := range registry.processes {
+		if !process.State.Attached && process.State.UpdateTime < expireTime {
+			delete(registry.processes, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+func (registry *AgentEventRegistry) Size() int {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+	return len(registry.processes)
+}
+
+func appendAgentError(reason string, state *AgentState) string {
+	if state.LastError == "" {
+		return reason
+	}
+	return fmt.Sprintf("%s, last error at %s: %s", reason, formatAgentEventTime(state.LastErrorTime), state.LastError)
+}
+
+func formatAgentEventTime(timestamp uint64) string {
+	return time.Unix(0, int64(timestamp)).Format("2006-01-02 15:04:05.000")
+}
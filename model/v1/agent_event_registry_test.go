@@ -0,0 +1,138 @@
+package model
+
+import (
+	"strings"
+	"testing"
+)
+
+func newTestAgentEvent(timestamp uint64, name string, status bool) *AgentEvent {
+	return &AgentEvent{
+		Timestamp: timestamp,
+		Name:      name,
+		Pid:       100,
+		Labels:    map[string]string{AgentLabelNodeName: "node-1", AgentLabelError: "attach failed"},
+		Status:    status,
+	}
+}
+
+func TestAgentEventRegistry_ExplainNotProfiled(t *testing.T) {
+	registry := NewAgentEventRegistry(nil)
+	// Out of order events.
+	registry.AddEvent(newTestAgentEvent(500, AgentEventProfiling, false))
+	registry.AddEvent(newTestAgentEvent(100, AgentEventAttach, true))
+	registry.AddEvent(newTestAgentEvent(200, AgentEventProfiling, true))
+	registry.AddEvent(newTestAgentEvent(700, AgentEventAttach, false))
+	registry.AddEvent(newTestAgentEvent(800, AgentEventError, false))
+
+	tests := []struct {
+		name       string
+		pid        uint32
+		startTime  uint64
+		endTime    uint64
+		wantReason string
+	}{
+		{"unknown pid", 101, 200, 300, "no agent event"},
+		{"before attach", 100, 50, 150, "agent is not attached"},
+		{"attached without profiling", 100, 150, 180, "profiling is off since"},
+		{"profiling", 100, 200, 400, ""},
+		{"turned off during trace", 100, 400, 600, "profiling is turned off at"},
+		{"profiling off", 100, 600, 650, "profiling is off since"},
+		{"detached with error", 100, 900, 1000, "last error at"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := registry.ExplainNotProfiled("node-1", tt.pid, tt.startTime, tt.endTime)
+			if tt.wantReason == "" && reason != "" || !strings.Contains(reason, tt.wantReason) {
+				t.Errorf("ExplainNotProfiled() = %q, want %q", reason, tt.wantReason)
+			}
+			if active := registry.IsProfilingActive("node-1", tt.pid, tt.startTime, tt.endTime); active != (tt.wantReason == "") {
+				t.Errorf("IsProfilingActive() = %v", active)
+			}
+		})
+	}
+
+	process, _ := registry.GetProcess("node-1", 100)
+	if process.State.Attached || process.State.LastError != "attach failed" || len(process.History) != 5 {
+		t.Errorf("GetProcess() = %+v", process)
+	}
+	if removed := registry.RemoveDetached(900); removed != 1 || registry.Size() != 0 {
+		t.Errorf("RemoveDetached() = %d, size = %d", removed, registry.Size())
+	}
+}
+
+func TestAgentEventRegistry_History(t *testing.T) {
+	registry := NewAgentEventRegistry(&AgentEventConfig{MaxHistory: 2})
+	registry.AddEvent(newTestAgentEvent(100, AgentEventAttach, true))
+	registry.AddEvent(newTestAgentEvent(200, AgentEventProfiling, true))
+	registry.AddEvent(newTestAgentEvent(300, AgentEventProfiling, false))
+	// Older than kept history.
+	registry.AddEvent(newTestAgentEvent(50, AgentEventAttach, false))
+
+	process, _ := registry.GetProcess("node-1", 100)
+	if len(process.History) != 2 || process.History[0].Timestamp != 200 {
+		t.Errorf("History = %v", process.History)
+	}
+	if state, _ := registry.GetStateAt("node-1", 100, 150); !state.Attached || state.Profiling {
+		t.Errorf("GetStateAt(150) = %+v, want attached", state)
+	}
+}
+
+func TestAgentEventRegistry_ExplainTraceTree(t *testing.T) {
+	registry := NewAgentEventRegistry(nil)
+	registry.AddEvent(newTestAgentEvent(100, AgentEventAttach, true))
+	registry.AddEvent(newTestAgentEvent(100, AgentEventProfiling, true))
+
+	root := &TraceTreeNode{NodeName: "node-1", Pid: 100, StartTime: 200, TotalTime: 100, IsTraced: true}
+	child := &TraceTreeNode{NodeName: "node-2", Pid: 100, StartTime: 200, TotalTime: 50, IsTraced: true}
+	profiled := &TraceTreeNode{NodeName: "node-1", Pid: 100, IsTraced: true, IsProfiled: true}
+	root.AddChild(child)
+	root.AddChild(profiled)
+	registry.ExplainTraceTree(root)
+
+	if !strings.Contains(root.NotProfiledReason, "no profiling data") {
+		t.Errorf("root reason = %q", root.NotProfiledReason)
+	}
+	if !strings.Contains(child.NotProfiledReason, "no agent event") {
+		t.Errorf("child reason = %q", child.NotProfiledReason)
+	}
+	if profiled.NotProfiledReason != "" {
+		t.Errorf("profiled reason = %q", profiled.NotProfiledReason)
+	}
+}
+
+func TestAgentEventRegistry_StateChangeTime(t *testing.T) {
+	registry := NewAgentEventRegistry(nil)
+	registry.AddEvent(newTestAgentEvent(100, AgentEventAttach, true))
+	registry.AddEvent(newTestAgentEvent(200, AgentEventProfiling, true))
+	registry.AddEvent(newTestAgentEvent(300, AgentEventProfiling, false))
+	// Error and repeated profiling off don't change the state.
+	registry.AddEvent(newTestAgentEvent(400, AgentEventError, false))
+	registry.AddEvent(newTestAgentEvent(450, AgentEventProfiling, false))
+
+	want := "profiling is off since " + formatAgentEventTime(300)
+	if reason := registry.ExplainNotProfiled("node-1", 100, 500, 600); !strings.HasPrefix(reason, want) {
+		t.Errorf("ExplainNotProfiled() = %q, want prefix %q", reason, want)
+	}
+	process, _ := registry.GetProcess("node-1", 100)
+	if process.State.StateChangeTime != 300 || process.State.UpdateTime != 450 {
+		t.Errorf("StateChangeTime = %d, UpdateTime = %d, want 300 and 450", process.State.StateChangeTime, process.State.UpdateTime)
+	}
+}
+
+func TestAgentEventRegistry_PidReused(t *testing.T) {
+	registry := NewAgentEventRegistry(nil)
+	registry.AddEvent(newTestAgentEvent(100, AgentEventAttach, true))
+	registry.AddEvent(newTestAgentEvent(200, AgentEventProfiling, true))
+	registry.AddEvent(newTestAgentEvent(300, AgentEventAttach, false))
+	registry.AddEvent(newTestAgentEvent(350, AgentEventError, false))
+	// New process with the same pid.
+	registry.AddEvent(newTestAgentEvent(400, AgentEventAttach, true))
+
+	process, _ := registry.GetProcess("node-1", 100)
+	if len(process.History) != 1 || !process.State.Attached || process.State.LastError != "" {
+		t.Errorf("GetProcess() = %+v, want history of new process", process)
+	}
+	if reason := registry.ExplainNotProfiled("node-1", 100, 500, 600); !strings.Contains(reason, "profiling is off since") {
+		t.Errorf("ExplainNotProfiled() = %q, profiling of old process is inherited", reason)
+	}
+}
@@ -1,8 +1,12 @@
 package model
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
+	"sort"
 	"strconv"
+	"strings"
 )
 
 type AttributeValueType int
@@ -11,6 +15,12 @@ const (
 	StringAttributeValueType AttributeValueType = iota
 	IntAttributeValueType
 	BooleanAttributeValueType
+	DoubleAttributeValueType
+	BytesAttributeValueType
+	StringArrayAttributeValueType
+	IntArrayAttributeValueType
+	DoubleArrayAttributeValueType
+	BooleanArrayAttributeValueType
 )
 
 type AttributeMap struct {
@@ -21,6 +31,103 @@ func (a AttributeMap) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a.values)
 }
 
+// UnmarshalJSON decodes integer as int, other number as double and homogeneous array as typed array.
+// Bytes are decoded as base64 string, object and mixed array are kept as json string.
+func (a *AttributeMap) UnmarshalJSON(data []byte) error {
+	var rawValues map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawValues); err != nil {
+		return err
+	}
+	a.values = make(map[string]AttributeValue, len(rawValues))
+	for key, rawValue := range rawValues {
+		decoder := json.NewDecoder(bytes.NewReader(rawValue))
+		decoder.UseNumber()
+		var value interface{}
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		if attributeValue := newAttributeValue(value, rawValue); attributeValue != nil {
+			a.values[key] = attributeValue
+		}
+	}
+	return nil
+}
+
+func newAttributeValue(value interface{}, rawValue json.RawMessage) AttributeValue {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return &stringValue{value: v}
+	case bool:
+		return &boolValue{value: v}
+	case json.Number:
+		if intV, err := v.Int64(); err == nil {
+			return &intValue{value: intV}
+		}
+		doubleV, _ := v.Float64()
+		return &doubleValue{value: doubleV}
+	case []interface{}:
+		if arrayValue := newArrayValue(v); arrayValue != nil {
+			return arrayValue
+		}
+	}
+	return &stringValue{value: string(rawValue)}
+}
+
+func newArrayValue(values []interface{}) AttributeValue {
+	if len(values) == 0 {
+		return &stringArrayValue{value: []string{}}
+	}
+	switch values[0].(type) {
+	case string:
+		array := make([]string, 0, len(values))
+		for _, value := range values {
+			v, ok := value.(string)
+			if !ok {
+				return nil
+			}
+			array = append(array, v)
+		}
+		return &stringArrayValue{value: array}
+	case bool:
+		array := make([]bool, 0, len(values))
+		for _, value := range values {
+			v, ok := value.(bool)
+			if !ok {
+				return nil
+			}
+			array = append(array, v)
+		}
+		return &boolArrayValue{value: array}
+	case json.Number:
+		ints := make([]int64, 0, len(values))
+		doubles := make([]float64, 0, len(values))
+		isInt := true
+		for _, value := range values {
+			v, ok := value.(json.Number)
+			if !ok {
+				return nil
+			}
+			if intV, err := v.Int64(); err == nil && isInt {
+				ints = append(ints, intV)
+			} else {
+				isInt = false
+			}
+			doubleV, err := v.Float64()
+			if err != nil {
+				return nil
+			}
+			doubles = append(doubles, doubleV)
+		}
+		if isInt {
+			return &intArrayValue{value: ints}
+		}
+		return &doubleArrayValue{value: doubles}
+	}
+	return nil
+}
+
 func NewAttributeMap() *AttributeMap {
 	values := make(map[string]AttributeValue)
 	return &AttributeMap{values}
@@ -35,6 +142,46 @@ func (a *AttributeMap) HasAttribute(key string) bool {
 	return existing
 }
 
+func (a *AttributeMap) Get(key string) (AttributeValue, bool) {
+	value, existing := a.values[key]
+	return value, existing
+}
+
+func (a *AttributeMap) Remove(key string) bool {
+	if _, existing := a.values[key]; existing {
+		delete(a.values, key)
+		return true
+	}
+	return false
+}
+
+// Range calls f for each attribute in key order until f returns false.
+func (a *AttributeMap) Range(f func(key string, value AttributeValue) bool) {
+	for _, key := range a.Keys() {
+		if !f(key, a.values[key]) {
+			return
+		}
+	}
+}
+
+func (a *AttributeMap) Keys() []string {
+	keys := make([]string, 0, len(a.values))
+	for key := range a.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Clone returns a copy of map, values are immutable and shared.
+func (a *AttributeMap) Clone() *AttributeMap {
+	clone := NewAttributeMap()
+	for key, value := range a.values {
+		clone.values[key] = value
+	}
+	return clone
+}
+
 func (a *AttributeMap) GetStringValue(key string) string {
 	value := a.values[key]
 	if x, ok := value.(*stringValue); ok {
@@ -77,6 +224,90 @@ func (a *AttributeMap) AddBoolValue(key string, value bool) {
 	}
 }
 
+func (a *AttributeMap) GetDoubleValue(key string) float64 {
+	value := a.values[key]
+	if x, ok := value.(*doubleValue); ok {
+		return x.value
+	}
+	return 0
+}
+
+func (a *AttributeMap) AddDoubleValue(key string, value float64) {
+	a.values[key] = &doubleValue{
+		value: value,
+	}
+}
+
+func (a *AttributeMap) GetBytesValue(key string) []byte {
+	value := a.values[key]
+	if x, ok := value.(*bytesValue); ok {
+		return x.value
+	}
+	return nil
+}
+
+func (a *AttributeMap) AddBytesValue(key string, value []byte) {
+	a.values[key] = &bytesValue{
+		value: append([]byte{}, value...),
+	}
+}
+
+func (a *AttributeMap) GetStringArrayValue(key string) []string {
+	value := a.values[key]
+	if x, ok := value.(*stringArrayValue); ok {
+		return x.value
+	}
+	return nil
+}
+
+func (a *AttributeMap) AddStringArrayValue(key string, value []string) {
+	a.values[key] = &stringArrayValue{
+		value: append([]string{}, value...),
+	}
+}
+
+func (a *AttributeMap) GetIntArrayValue(key string) []int64 {
+	value := a.values[key]
+	if x, ok := value.(*intArrayValue); ok {
+		return x.value
+	}
+	return nil
+}
+
+func (a *AttributeMap) AddIntArrayValue(key string, value []int64) {
+	a.values[key] = &intArrayValue{
+		value: append([]int64{}, value...),
+	}
+}
+
+func (a *AttributeMap) GetDoubleArrayValue(key string) []float64 {
+	value := a.values[key]
+	if x, ok := value.(*doubleArrayValue); ok {
+		return x.value
+	}
+	return nil
+}
+
+func (a *AttributeMap) AddDoubleArrayValue(key string, value []float64) {
+	a.values[key] = &doubleArrayValue{
+		value: append([]float64{}, value...),
+	}
+}
+
+func (a *AttributeMap) GetBoolArrayValue(key string) []bool {
+	value := a.values[key]
+	if x, ok := value.(*boolArrayValue); ok {
+		return x.value
+	}
+	return nil
+}
+
+func (a *AttributeMap) AddBoolArrayValue(key string, value []bool) {
+	a.values[key] = &boolArrayValue{
+		value: append([]bool{}, value...),
+	}
+}
+
 func (a *AttributeMap) ToStringMap() map[string]string {
 	stringMap := make(map[string]string)
 	if a == nil {
@@ -98,6 +329,15 @@ type AttributeValue interface {
 	ToString() string
 }
 
+// AttributeValueAsRaw returns string, int64, bool, float64, []byte or slice of them,
+// values not created by AttributeMap are returned as ToString().
+func AttributeValueAsRaw(value AttributeValue) interface{} {
+	if rawValue, ok := value.(interface{ AsRaw() interface{} }); ok {
+		return rawValue.AsRaw()
+	}
+	return value.ToString()
+}
+
 type stringValue struct {
 	value string
 }
@@ -114,6 +354,10 @@ func (v *stringValue) ToString() string {
 	return v.value
 }
 
+func (v *stringValue) AsRaw() interface{} {
+	return v.value
+}
+
 type intValue struct {
 	value int64
 }
@@ -130,6 +374,10 @@ func (v intValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(v.value)
 }
 
+func (v *intValue) AsRaw() interface{} {
+	return v.value
+}
+
 type boolValue struct {
 	value bool
 }
@@ -145,3 +393,151 @@ func (v *boolValue) ToString() string {
 func (v boolValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(v.value)
 }
+
+func (v *boolValue) AsRaw() interface{} {
+	return v.value
+}
+
+type doubleValue struct {
+	value float64
+}
+
+func (v *doubleValue) Type() AttributeValueType {
+	return DoubleAttributeValueType
+}
+
+func (v *doubleValue) ToString() string {
+	return strconv.FormatFloat(v.value, 'f', -1, 64)
+}
+
+func (v doubleValue) MarshalJSON() ([]byte, error) {
+	return marshalDouble(v.value)
+}
+
+func (v *doubleValue) AsRaw() interface{} {
+	return v.value
+}
+
+// marshalDouble keeps the decimal point of integral value, so it is decoded as double again.
+func marshalDouble(value float64) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.ContainsAny(data, ".eE") {
+		data = append(data, ".0"...)
+	}
+	return data, nil
+}
+
+type bytesValue struct {
+	value []byte
+}
+
+func (v *bytesValue) Type() AttributeValueType {
+	return BytesAttributeValueType
+}
+
+func (v *bytesValue) ToString() string {
+	return base64.StdEncoding.EncodeToString(v.value)
+}
+
+func (v bytesValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+func (v *bytesValue) AsRaw() interface{} {
+	return v.value
+}
+
+type stringArrayValue struct {
+	value []string
+}
+
+func (v *stringArrayValue) Type() AttributeValueType {
+	return StringArrayAttributeValueType
+}
+
+func (v *stringArrayValue) ToString() string {
+	data, _ := json.Marshal(v.value)
+	return string(data)
+}
+
+func (v stringArrayValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+func (v *stringArrayValue) AsRaw() interface{} {
+	return v.value
+}
+
+type intArrayValue struct {
+	value []int64
+}
+
+func (v *intArrayValue) Type() AttributeValueType {
+	return IntArrayAttributeValueType
+}
+
+func (v *intArrayValue) ToString() string {
+	data, _ := json.Marshal(v.value)
+	return string(data)
+}
+
+func (v intArrayValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+func (v *intArrayValue) AsRaw() interface{} {
+	return v.value
+}
+
+type doubleArrayValue struct {
+	value []float64
+}
+
+func (v *doubleArrayValue) Type() AttributeValueType {
+	return DoubleArrayAttributeValueType
+}
+
+func (v *doubleArrayValue) ToString() string {
+	data, _ := v.MarshalJSON()
+	return string(data)
+}
+
+func (v doubleArrayValue) MarshalJSON() ([]byte, error) {
+	values := make([]string, 0, len(v.value))
+	for _, value := range v.value {
+		data, err := marshalDouble(value)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, string(data))
+	}
+	return []byte("[" + strings.Join(values, ",") + "]"), nil
+}
+
+func (v *doubleArrayValue) AsRaw() interface{} {
+	return v.value
+}
+
+type boolArrayValue struct {
+	value []bool
+}
+
+func (v *boolArrayValue) Type() AttributeValueType {
+	return BooleanArrayAttributeValueType
+}
+
+func (v *boolArrayValue) ToString() string {
+	data, _ := json.Marshal(v.value)
+	return string(data)
+}
+
+func (v boolArrayValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+func (v *boolArrayValue) AsRaw() interface{} {
+	return v.value
+}
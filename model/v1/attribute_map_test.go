@@ -0,0 +1,69 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func newTestAttributeMap() *AttributeMap {
+	a := NewAttributeMap()
+	a.AddStringValue("str", "value")
+	a.AddIntValue("int", 42)
+	a.AddBoolValue("bool", true)
+	a.AddDoubleValue("double", 2)
+	a.AddBytesValue("bytes", []byte{1, 2, 3})
+	a.AddStringArrayValue("strs", []string{"a", "b"})
+	a.AddIntArrayValue("ints", []int64{1, 2})
+	a.AddDoubleArrayValue("doubles", []float64{1, 2.5})
+	a.AddBoolArrayValue("bools", []bool{true, false})
+	return a
+}
+
+func TestAttributeMap_JSON(t *testing.T) {
+	a := newTestAttributeMap()
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := NewAttributeMap()
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatal(err)
+	}
+
+	// Bytes are decoded as base64 string.
+	if got.GetStringValue("bytes") != "AQID" {
+		t.Errorf("bytes = %v", got.values["bytes"])
+	}
+	got.Remove("bytes")
+	a.Remove("bytes")
+	if !reflect.DeepEqual(got, a) {
+		t.Errorf("Unmarshal() = %s, want %s", got.String(), a.String())
+	}
+
+	if err := json.Unmarshal([]byte(`{"mixed":[1,"a"],"obj":{"k":1},"null":null,"big":1e3}`), got); err != nil {
+		t.Fatal(err)
+	}
+	if got.GetStringValue("mixed") != `[1,"a"]` || got.GetStringValue("obj") != `{"k":1}` || got.HasAttribute("null") || got.GetDoubleValue("big") != 1000 {
+		t.Errorf("Unmarshal() = %s", got.String())
+	}
+}
+
+func TestAttributeMap_Range(t *testing.T) {
+	keys := make([]string, 0)
+	newTestAttributeMap().Range(func(key string, value AttributeValue) bool {
+		keys = append(keys, key)
+		return key != "double"
+	})
+	if !reflect.DeepEqual(keys, []string{"bool", "bools", "bytes", "double"}) {
+		t.Errorf("Range() keys = %v", keys)
+	}
+}
+
+func TestErrorSpan_SetTypedAttributes(t *testing.T) {
+	span := &ErrorSpan{}
+	span.SetTypedAttributes(newTestAttributeMap())
+	if span.Attributes["int"] != "42" || span.TypedAttributes.GetIntValue("int") != 42 {
+		t.Errorf("span = %+v", span)
+	}
+}
@@ -0,0 +1,24 @@
+package model
+
+type CallPatternType string
+
+const (
+	NPlusOneCallPattern   CallPatternType = "NPlusOne"
+	ChattyCallPattern     CallPatternType = "ChattyCalls"
+	SequentialCallPattern CallPatternType = "SequentialCalls"
+	RetryStormCallPattern CallPatternType = "RetryStorm"
+)
+
+type CallPattern struct {
+	Type       CallPatternType `json:"type"`
+	ReqType    string          `json:"reqType"`
+	Target     string          `json:"target"`
+	Count      int             `json:"count"`
+	ErrorCount int             `json:"errorCount"`
+	TotalTime  uint64          `json:"totalTime"`
+	MaxTime    uint64          `json:"maxTime"`
+	// SavingTime is the estimated time saved if calls are batched or run in parallel.
+	SavingTime uint64   `json:"savingTime"`
+	Message    string   `json:"message"`
+	SpanIds    []string `json:"spanIds,omitempty"`
+}
@@ -0,0 +1,92 @@
+package model
+
+import "fmt"
+
+type SlowVerdict string
+
+const (
+	VerdictCPUBound         SlowVerdict = "CPUBound"
+	VerdictNetworkWait      SlowVerdict = "NetworkWait"
+	VerdictLockContention   SlowVerdict = "LockContention"
+	VerdictRunqStarvation   SlowVerdict = "RunqStarvation"
+	VerdictDiskIO           SlowVerdict = "DiskIO"
+	VerdictGcInduced        SlowVerdict = "GcInduced"
+	VerdictUnknown          SlowVerdict = "Unknown"
+	VerdictInsufficientData SlowVerdict = "InsufficientData"
+)
+
+const (
+	// Verdict is given only when events cover at least minCoveragePercent of node duration.
+	minCoveragePercent = 50
+	// Verdict is given only when the dominant category takes at least minDominantPercent of observed time.
+	minDominantPercent = 50
+)
+
+// CpuBreakdown is the on/off-CPU time of the thread which served the node.
+type CpuBreakdown struct {
+	Tid        uint64 `json:"tid"`
+	ThreadName string `json:"threadName"`
+	StartTime  uint64 `json:"startTime"`
+	EndTime    uint64 `json:"endTime"`
+	// Time observed by cpu events in [StartTime, EndTime].
+	ObservedTime uint64            `json:"observedTime"`
+	Times        map[string]uint64 `json:"times"`
+	Verdict      SlowVerdict       `json:"verdict"`
+	Message      string            `json:"message"`
+}
+
+// NewCpuBreakdown aggregates the cpu events of thread in the time window of node and classifies the slowness.
+func NewCpuBreakdown(node *TraceTreeNode, tid uint64, threadName string, events []*CpuEvent) *CpuBreakdown {
+	profiles := NewProfiles(node.StartTime, node.StartTime+node.TotalTime)
+	profiles.Tid = tid
+	profiles.ThreadName = threadName
+	profiles.AddCpuEvents(events)
+	observedTime := profiles.CalcProfileEventMetrics()
+
+	breakdown := &CpuBreakdown{
+		Tid:          tid,
+		ThreadName:   threadName,
+		StartTime:    profiles.StartTime,
+		EndTime:      profiles.EndTime,
+		ObservedTime: observedTime,
+		Times:        make(map[string]uint64, CPUTYPE_MAX),
+	}
+	for i, name := range CPUTypes {
+		breakdown.Times[name] = profiles.AggTime.Times[i]
+	}
+	breakdown.Verdict, breakdown.Message = classifyAggTime(&profiles.AggTime, observedTime, node.TotalTime)
+	return breakdown
+}
+
+func classifyAggTime(aggTime *AggregatedTime, observedTime uint64, totalTime uint64) (SlowVerdict, string) {
+	if totalTime == 0 || observedTime*100 < totalTime*minCoveragePercent {
+		return VerdictInsufficientData, fmt.Sprintf("cpu events cover %s of %s", formatNs(observedTime), formatNs(totalTime))
+	}
+
+	categories := []struct {
+		verdict SlowVerdict
+		time    uint64
+		message string
+	}{
+		{VerdictCPUBound, aggTime.Times[CPUType_ON], "running on cpu"},
+		{VerdictNetworkWait, aggTime.Times[CPUType_NET] + aggTime.Times[CPUType_EPOLL], "waiting on network"},
+		{VerdictLockContention, aggTime.Times[CPUType_FUTEX], "waiting on lock"},
+		{VerdictRunqStarvation, aggTime.Times[CPUType_RUNQ], "waiting in run queue"},
+		{VerdictDiskIO, aggTime.Times[CPUType_FILE], "waiting on disk"},
+	}
+	dominant := 0
+	for i, category := range categories {
+		if category.time > categories[dominant].time {
+			dominant = i
+		}
+	}
+	category := categories[dominant]
+	if category.time*100 < observedTime*minDominantPercent {
+		return VerdictUnknown, fmt.Sprintf("no dominant cause, %s %s of %s observed", category.message, formatNs(category.time), formatNs(observedTime))
+	}
+	return category.verdict, fmt.Sprintf("%s %s of %s observed", category.message, formatNs(category.time), formatNs(observedTime))
+}
+
+func formatNs(ns uint64) string {
+	return fmt.Sprintf("%.2fms", float64(ns)/1e6)
+}
@@ -0,0 +1,45 @@
+package model
+
+import "testing"
+
+func TestNewCpuBreakdown(t *testing.T) {
+	node := &TraceTreeNode{StartTime: 1e9, TotalTime: 100e6}
+	tests := []struct {
+		name   string
+		events []*CpuEvent
+		want   SlowVerdict
+	}{
+		{
+			name: "network",
+			events: []*CpuEvent{{
+				StartTime: 1e9, EndTime: 1e9 + 100e6,
+				TypeSpecs: []uint64{10e6, 80e6, 10e6}, TimeType: []CPUType{CPUType_ON, CPUType_NET, CPUType_ON}, RunqLatency: []uint64{1000},
+			}},
+			want: VerdictNetworkWait,
+		},
+		{
+			name: "runq",
+			events: []*CpuEvent{{
+				StartTime: 1e9, EndTime: 1e9 + 100e6,
+				TypeSpecs: []uint64{10e6, 90e6}, TimeType: []CPUType{CPUType_ON, CPUType_FUTEX}, RunqLatency: []uint64{60000},
+			}},
+			want: VerdictRunqStarvation,
+		},
+		{
+			name: "missing",
+			events: []*CpuEvent{{
+				StartTime: 1e9, EndTime: 1e9 + 10e6,
+				TypeSpecs: []uint64{10e6}, TimeType: []CPUType{CPUType_ON},
+			}},
+			want: VerdictInsufficientData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewCpuBreakdown(node, 1, "main", tt.events)
+			if got.Verdict != tt.want {
+				t.Errorf("Verdict = %s, want %s, message: %s, times: %v", got.Verdict, tt.want, got.Message, got.Times)
+			}
+		})
+	}
+}
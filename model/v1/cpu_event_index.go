@@ -0,0 +1,75 @@
+package model
+
+import "sort"
+
+// CpuEventIndex indexes the cpu events of one thread by time, events can be added in any order.
+type CpuEventIndex struct {
+	events []*CpuEvent
+	// maxEndTimes[i] is the max EndTime of events[0..i], used to skip events ended before query window.
+	maxEndTimes []uint64
+}
+
+func NewCpuEventIndex(events []*CpuEvent) *CpuEventIndex {
+	sorted := make([]*CpuEvent, 0, len(events))
+	exists := make(map[[2]uint64]bool, len(events))
+	for _, event := range events {
+		if event == nil || event.EndTime <= event.StartTime {
+			continue
+		}
+		// Same event may be reported by overlapped queries.
+		key := [2]uint64{event.StartTime, event.EndTime}
+		if exists[key] {
+			continue
+		}
+		exists[key] = true
+		sorted = append(sorted, event)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StartTime < sorted[j].StartTime
+	})
+
+	maxEndTimes := make([]uint64, len(sorted))
+	var maxEndTime uint64
+	for i, event := range sorted {
+		if event.EndTime > maxEndTime {
+			maxEndTime = event.EndTime
+		}
+		maxEndTimes[i] = maxEndTime
+	}
+	return &CpuEventIndex{events: sorted, maxEndTimes: maxEndTimes}
+}
+
+func (index *CpuEventIndex) Len() int {
+	return len(index.events)
+}
+
+// Query returns the events overlapped with [startTime, endTime) sorted by StartTime,
+// events which only touch the boundary are not included.
+func (index *CpuEventIndex) Query(startTime uint64, endTime uint64) []*CpuEvent {
+	result := make([]*CpuEvent, 0)
+	if endTime <= startTime {
+		return result
+	}
+	// Events start at or after endTime are not overlapped.
+	hi := sort.Search(len(index.events), func(i int) bool {
+		return index.events[i].StartTime >= endTime
+	})
+	// Events before lo all end at or before startTime.
+	lo := sort.Search(hi, func(i int) bool {
+		return index.maxEndTimes[i] > startTime
+	})
+	for i := lo; i < hi; i++ {
+		if index.events[i].EndTime > startTime {
+			result = append(result, index.events[i])
+		}
+	}
+	return result
+}
+
+// NewProfiles returns profiles of [startTime, endTime) with overlapped events and calculated AggTime.
+func (index *CpuEventIndex) NewProfiles(startTime uint64, endTime uint64) *Profiles {
+	profiles := NewProfiles(startTime, endTime)
+	profiles.CpuEvents = index.Query(startTime, endTime)
+	profiles.CalcProfileEventMetrics()
+	return profiles
+}
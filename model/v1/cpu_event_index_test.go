@@ -0,0 +1,137 @@
+package model
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestCpuEventIndex_Query(t *testing.T) {
+	e1 := &CpuEvent{StartTime: 100, EndTime: 200}
+	e2 := &CpuEvent{StartTime: 200, EndTime: 300}
+	e3 := &CpuEvent{StartTime: 150, EndTime: 500}
+	e4 := &CpuEvent{StartTime: 600, EndTime: 700}
+	// Unsorted and duplicated.
+	index := NewCpuEventIndex([]*CpuEvent{e4, e2, e1, e3, {StartTime: 100, EndTime: 200}})
+	if index.Len() != 4 {
+		t.Fatalf("Len() = %d, want 4", index.Len())
+	}
+
+	tests := []struct {
+		name  string
+		start uint64
+		end   uint64
+		want  []*CpuEvent
+	}{
+		{"touch end of e1 and start of e2", 200, 200, []*CpuEvent{}},
+		{"end at start of e2", 180, 200, []*CpuEvent{e1, e3}},
+		{"start at end of e3", 500, 600, []*CpuEvent{}},
+		{"inside e3", 350, 400, []*CpuEvent{e3}},
+		{"cover all", 0, 1000, []*CpuEvent{e1, e3, e2, e4}},
+		{"before all", 0, 100, []*CpuEvent{}},
+		{"after all", 700, 800, []*CpuEvent{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := index.Query(tt.start, tt.end); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Query(%d, %d) = %v, want %v", tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCpuEventIndex_QueryRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	events := make([]*CpuEvent, 0)
+	for i := 0; i < 200; i++ {
+		start := uint64(random.Intn(10000))
+		events = append(events, &CpuEvent{StartTime: start, EndTime: start + 1 + uint64(random.Intn(500))})
+	}
+	index := NewCpuEventIndex(events)
+	for i := 0; i < 100; i++ {
+		start := uint64(random.Intn(11000))
+		end := start + uint64(random.Intn(1000))
+		got := make(map[*CpuEvent]bool)
+		for _, event := range index.Query(start, end) {
+			got[event] = true
+		}
+		want := 0
+		for _, event := range index.events {
+			if event.StartTime < end && event.EndTime > start {
+				want++
+				if !got[event] {
+					t.Fatalf("Query(%d, %d) misses [%d, %d]", start, end, event.StartTime, event.EndTime)
+				}
+			}
+		}
+		if len(got) != want {
+			t.Fatalf("Query(%d, %d) = %d events, want %d", start, end, len(got), want)
+		}
+	}
+}
+
+func TestProfiles_CalcProfileEventMetrics(t *testing.T) {
+	const us = 1000
+	// on [1000us, 1400us), net [1400us, 2000us), on [2000us, 2500us).
+	newEvent := func(runqLatency uint64) *CpuEvent {
+		return &CpuEvent{
+			StartTime:   1000 * us,
+			EndTime:     2500 * us,
+			TypeSpecs:   []uint64{400 * us, 600 * us, 500 * us},
+			TimeType:    []CPUType{CPUType_ON, CPUType_NET, CPUType_ON},
+			RunqLatency: []uint64{runqLatency},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		start       uint64
+		end         uint64
+		runqLatency uint64
+		wantTimes   map[CPUType]uint64
+	}{
+		{"window covers event", 0, 3000 * us, 0, map[CPUType]uint64{CPUType_ON: 900 * us, CPUType_NET: 600 * us}},
+		{"clip both sides of one segment", 1500 * us, 1600 * us, 0, map[CPUType]uint64{CPUType_NET: 100 * us}},
+		{"clip start", 1200 * us, 3000 * us, 0, map[CPUType]uint64{CPUType_ON: 700 * us, CPUType_NET: 600 * us}},
+		{"clip end", 0, 1200 * us, 0, map[CPUType]uint64{CPUType_ON: 200 * us}},
+		{"window starts at event end", 2500 * us, 3000 * us, 0, map[CPUType]uint64{}},
+		{"window ends at event start", 0, 1000 * us, 0, map[CPUType]uint64{}},
+		// p.StartTime-onoffTime used to underflow when window start is smaller than segment time.
+		{"small window start", 10, 1100 * us, 0, map[CPUType]uint64{CPUType_ON: 100 * us}},
+		{"runq at the end of off segment", 1000 * us, 2500 * us, 200, map[CPUType]uint64{CPUType_ON: 900 * us, CPUType_NET: 400 * us, CPUType_RUNQ: 200 * us}},
+		{"window ends before runq", 1000 * us, 1700 * us, 200, map[CPUType]uint64{CPUType_ON: 400 * us, CPUType_NET: 300 * us}},
+		{"window inside runq", 1900 * us, 1950 * us, 200, map[CPUType]uint64{CPUType_RUNQ: 50 * us}},
+		{"runq longer than off segment", 0, 3000 * us, 1000, map[CPUType]uint64{CPUType_ON: 900 * us, CPUType_RUNQ: 600 * us}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles := NewProfiles(tt.start, tt.end)
+			profiles.AddCpuEvents([]*CpuEvent{newEvent(tt.runqLatency)})
+			var wantSum uint64
+			for _, time := range tt.wantTimes {
+				wantSum += time
+			}
+			if got := profiles.CalcProfileEventMetrics(); got != wantSum {
+				t.Errorf("CalcProfileEventMetrics() = %d, want %d", got, wantSum)
+			}
+			for cpuType, time := range profiles.AggTime.Times {
+				if time != tt.wantTimes[CPUType(cpuType)] {
+					t.Errorf("Times[%s] = %d, want %d", CPUTypes[cpuType], time, tt.wantTimes[CPUType(cpuType)])
+				}
+			}
+		})
+	}
+}
+
+func TestProfiles_AddCpuEvents(t *testing.T) {
+	profiles := NewProfiles(100, 300)
+	e1 := &CpuEvent{StartTime: 50, EndTime: 150}
+	e2 := &CpuEvent{StartTime: 150, EndTime: 250}
+	e3 := &CpuEvent{StartTime: 300, EndTime: 400}
+	profiles.AddCpuEvents([]*CpuEvent{e2, e3})
+	// e2 is added again by overlapped query, e1 is added after e2.
+	profiles.AddCpuEvents([]*CpuEvent{e1, {StartTime: 150, EndTime: 250}})
+	if !reflect.DeepEqual(profiles.CpuEvents, []*CpuEvent{e1, e2}) {
+		t.Errorf("CpuEvents = %v", profiles.CpuEvents)
+	}
+}
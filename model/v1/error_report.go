@@ -1,19 +1,22 @@
 package model
 
 type ErrorReport struct {
-	Name      string           `json:"name"`
-	Timestamp uint64           `json:"timestamp"`
-	TraceId   string           `json:"trace_id"`
-	IsDrop    bool             `json:"is_drop"`
-	Duration  uint64           `json:"duration"`
-	Data      *ErrorReportData `json:"data"`
+	SchemaVersion int              `json:"schema_version"`
+	Name          string           `json:"name"`
+	Timestamp     uint64           `json:"timestamp"`
+	TraceId       string           `json:"trace_id"`
+	IsDrop        bool             `json:"is_drop"`
+	Duration      uint64           `json:"duration"`
+	Data          *ErrorReportData `json:"data"`
 }
 
 type ErrorReportData struct {
 	EntryService        string         `json:"entry_service,omitempty"`
 	EntryInstance       string         `json:"entry_instance,omitempty"`
+	EntryInstanceKey    string         `json:"entry_instance_key,omitempty"`
 	MutatedService      string         `json:"mutated_service,omitempty"`
 	MutatedInstance     string         `json:"mutated_instance,omitempty"`
+	MutatedInstanceKey  string         `json:"mutated_instance_key,omitempty"`
 	MutatedUrl          string         `json:"mutated_url,omitempty"`
 	MutatedSpan         string         `json:"span_id,omitempty"`
 	MutatedPod          string         `json:"mutated_pod,omitempty"`
@@ -24,6 +27,7 @@ type ErrorReportData struct {
 	Cause               string         `json:"cause,omitempty"`
 	CauseMessage        string         `json:"cause_message,omitempty"`
 	RelationTree        *ErrorTreeNode `json:"relation_trees"`
+	ElidedNodes         int            `json:"elided_nodes,omitempty"`
 
 	ThresholdType     ThresholdType  `json:"threshold_type"`
 	ThresholdValue    float64        `json:"threshold_value"`
@@ -5,7 +5,9 @@ type ErrorSpan struct {
 	StartTime  uint64            `json:"startTime"`
 	TotalTime  uint64            `json:"totalTime"`
 	Attributes map[string]string `json:"attributes"`
-	Exceptions []*Exception      `json:"exceptions"`
+	// Attributes with original value types, Attributes keeps the string values for compatibility.
+	TypedAttributes *AttributeMap `json:"typedAttributes,omitempty"`
+	Exceptions      []*Exception  `json:"exceptions"`
 }
 
 func NewErrorSpan(name string, startTime uint64, duration uint64) *ErrorSpan {
@@ -22,6 +24,17 @@ func (span *ErrorSpan) AddAttribute(key string, value string) {
 	span.Attributes[key] = value
 }
 
+// SetTypedAttributes sets typed attributes and their string values to Attributes.
+func (span *ErrorSpan) SetTypedAttributes(attributes *AttributeMap) {
+	if span.Attributes == nil {
+		span.Attributes = make(map[string]string, attributes.Size())
+	}
+	span.TypedAttributes = attributes
+	for k, v := range attributes.ToStringMap() {
+		span.Attributes[k] = v
+	}
+}
+
 type Exception struct {
 	Timestamp uint64 `json:"timestamp"`
 	Type      string `json:"type"`
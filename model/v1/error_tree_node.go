@@ -2,6 +2,7 @@ package model
 
 type ErrorTreeNode struct {
 	Id                string         `json:"id"`
+	InstanceKey       string         `json:"instanceKey,omitempty"`
 	ServiceName       string         `json:"serviceName"`
 	Url               string         `json:"url"`
 	StartTime         uint64         `json:"startTime"`
@@ -32,7 +33,10 @@ type ErrorTreeNode struct {
 	Pid            uint32           `json:"-"`
 	Children       []*ErrorTreeNode `json:"children"`
 	ErrorSpans     []*ErrorSpan     `json:"errorSpans"`
-	Parent         *ErrorTreeNode   `json:"-"`
+	Collapsed      *CollapsedNodes  `json:"collapsed,omitempty"`
+	// Why the traced node is not profiled, see AgentEventRegistry.
+	NotProfiledReason string         `json:"notProfiledReason,omitempty"`
+	Parent            *ErrorTreeNode `json:"-"`
 }
 
 func (node *ErrorTreeNode) GetRootCauseError() *Exception {
@@ -49,11 +53,25 @@ func (node *ErrorTreeNode) GetRootCauseError() *Exception {
 	return earliestException
 }
 
+// GetMutatedNode returns the root cause error node along the path, nil if not found.
+func (node *ErrorTreeNode) GetMutatedNode() *ErrorTreeNode {
+	if node.IsMutated {
+		return node
+	}
+	for _, child := range node.Children {
+		if child.IsPath {
+			return child.GetMutatedNode()
+		}
+	}
+	return nil
+}
+
 func (node *ErrorTreeNode) SetSampled(sampledTrace *Trace) {
 	node.Id = sampledTrace.GetInstanceId()
+	node.InstanceKey = sampledTrace.GetInstanceKey()
 	sampledTraceLabel := sampledTrace.Labels
 	node.Url = sampledTraceLabel.Url
-	node.P90 = uint64(sampledTraceLabel.ThresholdValue / sampledTraceLabel.ThresholdMultiple)
+	node.P90 = sampledTraceLabel.ThresholdType.GetBaseline(sampledTraceLabel.ThresholdValue, sampledTraceLabel.ThresholdMultiple)
 	node.ThresholdValue = sampledTraceLabel.ThresholdValue
 	node.ThresholdType = sampledTraceLabel.ThresholdType
 	node.ThresholdRange = sampledTraceLabel.ThresholdRange
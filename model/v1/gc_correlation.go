@@ -0,0 +1,179 @@
+package model
+
+import "sort"
+
+// GC overlapping at least DefaultGcInducedPercent of node self time is treated as the root cause.
+const DefaultGcInducedPercent = 50
+
+type GcPause struct {
+	Type      string `json:"type"`
+	StartTime uint64 `json:"startTime"` // ns
+	Duration  uint64 `json:"duration"`  // ns
+	// Node self time overlapped by this pause.
+	OverlapTime uint64 `json:"overlapTime"`
+}
+
+func (pause *GcPause) GetEndTime() uint64 {
+	return pause.StartTime + pause.Duration
+}
+
+type GcCorrelation struct {
+	Pauses         []*GcPause `json:"pauses"`
+	SelfTime       uint64     `json:"selfTime"`
+	OverlapTime    uint64     `json:"overlapTime"`
+	OverlapPercent float64    `json:"overlapPercent"`
+	IsGcInduced    bool       `json:"isGcInduced"`
+}
+
+// GetPauses returns the young and full gc which happened since last sample.
+func (gc *Gc) GetPauses() []*GcPause {
+	pauses := make([]*GcPause, 0, 2)
+	if gc.IsYgc() {
+		pauses = append(pauses, &GcPause{Type: gc.GetYGcType(), StartTime: gc.YgcStartTime * 1e6, Duration: gc.YgcDuration})
+	}
+	if gc.IsFgc() {
+		pauses = append(pauses, &GcPause{Type: gc.GetFGcType(), StartTime: gc.FgcStartTime * 1e6, Duration: gc.FgcDuration})
+	}
+	return pauses
+}
+
+// InstanceGc is a gc sample with the container or process it is collected from.
+type InstanceGc struct {
+	Source InstanceSource
+	*Gc
+}
+
+// SelectInstanceGcs returns gc collected from the instance of node, including gc before its container or process restarts.
+// Gc is selected by container and pid of node when resolver is nil or the instance is not resolved.
+func SelectInstanceGcs(resolver *InstanceResolver, node *TraceTreeNode, gcs []*InstanceGc) []*Gc {
+	match := func(source InstanceSource) bool {
+		if source.ContainerId != "" {
+			return source.ContainerId == NormalizeContainerId(node.ContainerId)
+		}
+		return source.Pid == node.Pid && (source.Node == node.NodeName || source.Node == node.NodeIp)
+	}
+	if resolver != nil && node.InstanceKey != "" {
+		if _, exist := resolver.GetInstance(node.InstanceKey); exist {
+			match = func(source InstanceSource) bool {
+				return resolver.Matches(node.InstanceKey, source)
+			}
+		}
+	}
+	result := make([]*Gc, 0)
+	for _, gc := range gcs {
+		if match(gc.Source) {
+			result = append(result, gc.Gc)
+		}
+	}
+	return result
+}
+
+type timeInterval struct {
+	start uint64
+	end   uint64
+}
+
+// CorrelateGc computes how much self time of node (time not spent in children) overlaps gc pauses of its instance.
+func CorrelateGc(node *TraceTreeNode, gcs []*Gc, gcInducedPercent int) *GcCorrelation {
+	if gcInducedPercent <= 0 {
+		gcInducedPercent = DefaultGcInducedPercent
+	}
+	selfIntervals := getSelfIntervals(node)
+	correlation := &GcCorrelation{Pauses: make([]*GcPause, 0)}
+	for _, interval := range selfIntervals {
+		correlation.SelfTime += interval.end - interval.start
+	}
+
+	nodeEndTime := node.StartTime + node.TotalTime
+	pauseIntervals := make([]timeInterval, 0)
+	exists := make(map[GcPause]bool)
+	for _, gc := range gcs {
+		if !gc.Match(node.StartTime, nodeEndTime) {
+			continue
+		}
+		for _, pause := range gc.GetPauses() {
+			// Same gc is reported by every sample until next gc.
+			if exists[*pause] || pause.StartTime >= nodeEndTime || pause.GetEndTime() <= node.StartTime {
+				continue
+			}
+			exists[*pause] = true
+			pauseInterval := timeInterval{start: pause.StartTime, end: pause.GetEndTime()}
+			pause.OverlapTime = getOverlapTime(selfIntervals, []timeInterval{pauseInterval})
+			correlation.Pauses = append(correlation.Pauses, pause)
+			pauseIntervals = append(pauseIntervals, pauseInterval)
+		}
+	}
+	if len(correlation.Pauses) == 0 || correlation.SelfTime == 0 {
+		return correlation
+	}
+
+	// Pauses may overlap each other, merge them before counting.
+	correlation.OverlapTime = getOverlapTime(selfIntervals, mergeIntervals(pauseIntervals))
+	correlation.OverlapPercent = float64(correlation.OverlapTime) * 100 / float64(correlation.SelfTime)
+	correlation.IsGcInduced = correlation.OverlapPercent >= float64(gcInducedPercent)
+	return correlation
+}
+
+func getSelfIntervals(node *TraceTreeNode) []timeInterval {
+	childIntervals := make([]timeInterval, 0, len(node.Children))
+	for _, child := range node.Children {
+		if child.TotalTime > 0 {
+			childIntervals = append(childIntervals, timeInterval{start: child.StartTime, end: child.StartTime + child.TotalTime})
+		}
+	}
+
+	selfIntervals := make([]timeInterval, 0)
+	current := node.StartTime
+	endTime := node.StartTime + node.TotalTime
+	for _, child := range mergeIntervals(childIntervals) {
+		if child.start > current {
+			selfIntervals = append(selfIntervals, timeInterval{start: current, end: min(child.start, endTime)})
+		}
+		if child.end > current {
+			current = child.end
+		}
+		if current >= endTime {
+			break
+		}
+	}
+	if current < endTime {
+		selfIntervals = append(selfIntervals, timeInterval{start: current, end: endTime})
+	}
+	return selfIntervals
+}
+
+func mergeIntervals(intervals []timeInterval) []timeInterval {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start < intervals[j].start
+	})
+	merged := make([]timeInterval, 0, len(intervals))
+	for _, interval := range intervals {
+		if last := len(merged) - 1; last >= 0 && interval.start <= merged[last].end {
+			if interval.end > merged[last].end {
+				merged[last].end = interval.end
+			}
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return merged
+}
+
+// getOverlapTime returns the overlapped time of two sorted and non-overlapping interval lists.
+func getOverlapTime(a []timeInterval, b []timeInterval) uint64 {
+	var overlap uint64
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		start := max(a[i].start, b[j].start)
+		end := min(a[i].end, b[j].end)
+		if end > start {
+			overlap += end - start
+		}
+		if a[i].end < b[j].end {
+			i++
+		} else {
+			j++
+		}
+	}
+	return overlap
+}
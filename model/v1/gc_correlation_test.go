@@ -0,0 +1,49 @@
+package model
+
+import "testing"
+
+func TestCorrelateGc(t *testing.T) {
+	// Node runs [1000ms, 1100ms), child takes [1020ms, 1060ms), self time is 60ms.
+	node := &TraceTreeNode{StartTime: 1000e6, TotalTime: 100e6}
+	node.AddChild(&TraceTreeNode{StartTime: 1020e6, TotalTime: 40e6})
+	gcs := []*Gc{
+		// Young gc [1050ms, 1090ms) overlaps 30ms self time, reported twice.
+		{YgcStartTime: 1050, YgcDuration: 40e6, YgcCount: 2, LastYgcCount: 1},
+		{YgcStartTime: 1050, YgcDuration: 40e6, YgcCount: 2, LastYgcCount: 1},
+		// Gc out of node window.
+		{FgcStartTime: 2000, FgcDuration: 100e6, FgcCount: 1},
+	}
+
+	correlation := CorrelateGc(node, gcs, 0)
+	if correlation.SelfTime != 60e6 || correlation.OverlapTime != 30e6 || len(correlation.Pauses) != 1 {
+		t.Fatalf("CorrelateGc() = %+v", correlation)
+	}
+	if !correlation.IsGcInduced {
+		t.Errorf("IsGcInduced = false, overlap %.2f%%", correlation.OverlapPercent)
+	}
+	if CorrelateGc(node, gcs, 60).IsGcInduced {
+		t.Errorf("IsGcInduced = true with 60%% threshold")
+	}
+}
+
+func TestSelectInstanceGcs(t *testing.T) {
+	before := &Gc{YgcStartTime: 100}
+	after := &Gc{YgcStartTime: 200}
+	other := &Gc{YgcStartTime: 300}
+	gcs := []*InstanceGc{
+		{Source: NewInstanceSource("docker://aaaaaaaaaaaa1111", "node-1", 10), Gc: before},
+		{Source: NewInstanceSource("docker://bbbbbbbbbbbb2222", "node-1", 20), Gc: after},
+		{Source: NewInstanceSource("docker://cccccccccccc3333", "node-1", 30), Gc: other},
+	}
+	node := &TraceTreeNode{InstanceKey: "pod:default/order-7d9f", ContainerId: "docker://bbbbbbbbbbbb2222", NodeName: "node-1", Pid: 20}
+	if got := SelectInstanceGcs(nil, node, gcs); len(got) != 1 || got[0] != after {
+		t.Errorf("SelectInstanceGcs() without resolver = %v, want gc of container", got)
+	}
+
+	resolver := NewInstanceResolver()
+	resolver.Resolve(newTestInstanceTrace(100, "order-7d9f", "docker://aaaaaaaaaaaa1111", "order", 10))
+	resolver.Resolve(newTestInstanceTrace(200, "order-7d9f", "docker://bbbbbbbbbbbb2222", "order", 20))
+	if got := SelectInstanceGcs(resolver, node, gcs); len(got) != 2 || got[0] != before || got[1] != after {
+		t.Errorf("SelectInstanceGcs() = %v, want gc before and after restart", got)
+	}
+}
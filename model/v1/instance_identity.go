@@ -0,0 +1,342 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+type InstanceKind string
+
+const (
+	PodInstance       InstanceKind = "pod"
+	ContainerInstance InstanceKind = "container"
+	ProcessInstance   InstanceKind = "process"
+	ServiceInstance   InstanceKind = "service"
+
+	shortContainerIdLength = 12
+
+	// New pid of process is taken as restart only if the previous process has no trace in this time.
+	DefaultProcessRestartTime = uint64(time.Minute)
+)
+
+// InstanceIdentity normalizes the pod, container, process and node of an instance.
+type InstanceIdentity struct {
+	ServiceName   string `json:"serviceName"`
+	NodeName      string `json:"nodeName"`
+	NodeIp        string `json:"nodeIp"`
+	Namespace     string `json:"namespace,omitempty"`
+	PodName       string `json:"podName,omitempty"`
+	ContainerId   string `json:"containerId,omitempty"`
+	ContainerName string `json:"containerName,omitempty"`
+	Pid           uint32 `json:"pid,omitempty"`
+}
+
+func NewInstanceIdentity(trace *Trace) *InstanceIdentity {
+	labels := trace.Labels
+	return &InstanceIdentity{
+		ServiceName:   labels.ServiceName,
+		NodeName:      labels.NodeName,
+		NodeIp:        labels.NodeIp,
+		Namespace:     trace.Namespace,
+		PodName:       trace.PodName,
+		ContainerId:   NormalizeContainerId(labels.ContainerId),
+		ContainerName: labels.ContainerName,
+		Pid:           labels.Pid,
+	}
+}
+
+// NormalizeContainerId removes the runtime prefix (eg. docker://) and keeps the short id.
+func NormalizeContainerId(containerId string) string {
+	if index := strings.Index(containerId, "://"); index != -1 {
+		containerId = containerId[index+3:]
+	}
+	containerId = strings.ToLower(strings.TrimSpace(containerId))
+	if len(containerId) > shortContainerIdLength {
+		containerId = containerId[:shortContainerIdLength]
+	}
+	return containerId
+}
+
+func (identity *InstanceIdentity) GetNode() string {
+	if identity.NodeName != "" {
+		return identity.NodeName
+	}
+	return identity.NodeIp
+}
+
+func (identity *InstanceIdentity) GetKind() InstanceKind {
+	if identity.PodName != "" {
+		return PodInstance
+	}
+	if identity.ContainerId != "" || identity.ContainerName != "" {
+		return ContainerInstance
+	}
+	if identity.Pid > 0 {
+		return ProcessInstance
+	}
+	return ServiceInstance
+}
+
+// GetKey returns the instance key which is stable when container or process of pod restarts
+// and when container of the same name is recreated. Process key contains the pid to keep concurrent replicas apart,
+// restart of process with a new pid is resolved by InstanceResolver.
+func (identity *InstanceIdentity) GetKey() string {
+	switch identity.GetKind() {
+	case PodInstance:
+		return fmt.Sprintf("pod:%s/%s", identity.Namespace, identity.PodName)
+	case ContainerInstance:
+		if identity.ContainerName != "" {
+			return fmt.Sprintf("container:%s/%s", identity.GetNode(), identity.ContainerName)
+		}
+		return fmt.Sprintf("container:%s/%s", identity.GetNode(), identity.ContainerId)
+	case ProcessInstance:
+		return fmt.Sprintf("process:%s/%s/%d", identity.GetNode(), identity.ServiceName, identity.Pid)
+	}
+	return GetServiceInstanceKey(identity.ServiceName)
+}
+
+// GetServiceInstanceKey returns the instance key of service whose node, container and process are unknown.
+func GetServiceInstanceKey(serviceName string) string {
+	return fmt.Sprintf("service:%s", serviceName)
+}
+
+func (trace *Trace) GetInstanceKey() string {
+	return NewInstanceIdentity(trace).GetKey()
+}
+
+// Instance is the resolved instance with the containers and processes it has run.
+type Instance struct {
+	Key          string            `json:"key"`
+	Identity     *InstanceIdentity `json:"identity"`
+	ContainerIds []string          `json:"containerIds"`
+	Pids         []uint32          `json:"pids"`
+	FirstSeen    uint64            `json:"firstSeen"`
+	LastSeen     uint64            `json:"lastSeen"`
+}
+
+// InstanceSource is the container or process on node which gc, profiles and logs are collected from.
+type InstanceSource struct {
+	ContainerId string
+	Node        string
+	Pid         uint32
+}
+
+func NewInstanceSource(containerId string, node string, pid uint32) InstanceSource {
+	return InstanceSource{ContainerId: NormalizeContainerId(containerId), Node: node, Pid: pid}
+}
+
+// InstanceResolver resolves the container id or node pid of gc, profiles and logs to the stable instance key.
+// Process of service with a new pid is resolved to the previous instance of the service on node
+// if the previous process has no trace in RestartTime, otherwise it is a concurrent replica.
+type InstanceResolver struct {
+	// In the unit of trace timestamp, default is DefaultProcessRestartTime.
+	RestartTime uint64
+
+	mutex      sync.RWMutex
+	instances  map[string]*Instance
+	containers map[string]string          // <containerId, key>
+	processes  map[instanceProcess]string // <node pid, key>
+	restarted  map[string]string          // <process key of new pid, key of previous instance>
+	services   map[string][]string        // <node/service, keys of process instances>
+}
+
+type instanceProcess struct {
+	node string
+	pid  uint32
+}
+
+func NewInstanceResolver() *InstanceResolver {
+	return &InstanceResolver{
+		instances:   make(map[string]*Instance),
+		containers:  make(map[string]string),
+		processes:   make(map[instanceProcess]string),
+		restarted:   make(map[string]string),
+		services:    make(map[string][]string),
+		RestartTime: DefaultProcessRestartTime,
+	}
+}
+
+// Resolve registers the identity of trace and returns its instance.
+func (resolver *InstanceResolver) Resolve(trace *Trace) *Instance {
+	return resolver.ResolveIdentity(NewInstanceIdentity(trace), trace.Timestamp)
+}
+
+func (resolver *InstanceResolver) ResolveIdentity(identity *InstanceIdentity, timestamp uint64) *Instance {
+	key := identity.GetKey()
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+
+	if identity.GetKind() == ProcessInstance {
+		key = resolver.resolveProcessKey(identity, key, timestamp)
+	}
+	instance, exist := resolver.instances[key]
+	if !exist {
+		instance = &Instance{
+			Key:          key,
+			ContainerIds: make([]string, 0, 1),
+			Pids:         make([]uint32, 0, 1),
+			FirstSeen:    timestamp,
+		}
+		resolver.instances[key] = instance
+	}
+	// Keep the latest identity, the previous containers and processes are still resolved to this instance.
+	if timestamp >= instance.LastSeen {
+		instance.Identity = identity
+		instance.LastSeen = timestamp
+	} else if instance.Identity == nil {
+		instance.Identity = identity
+	}
+	if timestamp < instance.FirstSeen {
+		instance.FirstSeen = timestamp
+	}
+	if identity.ContainerId != "" {
+		if !slices.Contains(instance.ContainerIds, identity.ContainerId) {
+			instance.ContainerIds = append(instance.ContainerIds, identity.ContainerId)
+		}
+		resolver.containers[identity.ContainerId] = key
+	}
+	if identity.Pid > 0 {
+		process := instanceProcess{node: identity.GetNode(), pid: identity.Pid}
+		if !slices.Contains(instance.Pids, identity.Pid) {
+			instance.Pids = append(instance.Pids, identity.Pid)
+		}
+		// Pid may be reused by another instance.
+		resolver.processes[process] = key
+	}
+	return instance
+}
+
+// resolveProcessKey returns the key of previous instance if process is restarted with a new pid.
+func (resolver *InstanceResolver) resolveProcessKey(identity *InstanceIdentity, key string, timestamp uint64) string {
+	if previous, exist := resolver.restarted[key]; exist {
+		return previous
+	}
+	if _, exist := resolver.instances[key]; exist {
+		return key
+	}
+	service := fmt.Sprintf("%s/%s", identity.GetNode(), identity.ServiceName)
+	var restarted *Instance
+	for _, previous := range resolver.services[service] {
+		instance := resolver.instances[previous]
+		if instance.LastSeen+resolver.RestartTime > timestamp {
+			// Previous process is still running.
+			continue
+		}
+		if restarted == nil || instance.LastSeen > restarted.LastSeen {
+			restarted = instance
+		}
+	}
+	if restarted == nil {
+		resolver.services[service] = append(resolver.services[service], key)
+		return key
+	}
+	resolver.restarted[key] = restarted.Key
+	return restarted.Key
+}
+
+// getKey returns the key of instance which key is resolved to.
+func (resolver *InstanceResolver) getKey(key string) string {
+	if previous, exist := resolver.restarted[key]; exist {
+		return previous
+	}
+	return key
+}
+
+// GetInstance returns a copy of instance, key of the restarted process returns the previous instance.
+func (resolver *InstanceResolver) GetInstance(key string) (*Instance, bool) {
+	resolver.mutex.RLock()
+	defer resolver.mutex.RUnlock()
+	instance, exist := resolver.instances[resolver.getKey(key)]
+	if !exist {
+		return nil, false
+	}
+	result := *instance
+	result.ContainerIds = slices.Clone(instance.ContainerIds)
+	result.Pids = slices.Clone(instance.Pids)
+	return &result, true
+}
+
+// ResolveContainer returns the instance key of container, containerId may be full or short id.
+func (resolver *InstanceResolver) ResolveContainer(containerId string) (string, bool) {
+	resolver.mutex.RLock()
+	defer resolver.mutex.RUnlock()
+	key, exist := resolver.containers[NormalizeContainerId(containerId)]
+	return key, exist
+}
+
+// ResolveSource returns the instance key of source, container is resolved first and then process.
+func (resolver *InstanceResolver) ResolveSource(source InstanceSource) (string, bool) {
+	if source.ContainerId != "" {
+		return resolver.ResolveContainer(source.ContainerId)
+	}
+	return resolver.ResolveProcess(source.Node, source.Pid)
+}
+
+// Matches checks whether source is resolved to the instance of key, the containers and processes before restart are matched too.
+func (resolver *InstanceResolver) Matches(key string, source InstanceSource) bool {
+	resolved, exist := resolver.ResolveSource(source)
+	if !exist {
+		return false
+	}
+	resolver.mutex.RLock()
+	defer resolver.mutex.RUnlock()
+	return resolved == resolver.getKey(key)
+}
+
+// ResolveProcess returns the instance key of pid on node, node is node name or node ip used in traces.
+func (resolver *InstanceResolver) ResolveProcess(node string, pid uint32) (string, bool) {
+	resolver.mutex.RLock()
+	defer resolver.mutex.RUnlock()
+	key, exist := resolver.processes[instanceProcess{node: node, pid: pid}]
+	return key, exist
+}
+
+// CleanExpired removes the instances not seen since expireTime, returns the number of removed instances.
+func (resolver *InstanceResolver) CleanExpired(expireTime uint64) int {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	removed := 0
+	for key, instance := range resolver.instances {
+		if instance.LastSeen >= expireTime {
+			continue
+		}
+		for _, containerId := range instance.ContainerIds {
+			if resolver.containers[containerId] == key {
+				delete(resolver.containers, containerId)
+			}
+		}
+		node := instance.Identity.GetNode()
+		for _, pid := range instance.Pids {
+			process := instanceProcess{node: node, pid: pid}
+			if resolver.processes[process] == key {
+				delete(resolver.processes, process)
+			}
+		}
+		if instance.Identity.GetKind() == ProcessInstance {
+			service := fmt.Sprintf("%s/%s", node, instance.Identity.ServiceName)
+			resolver.services[service] = slices.DeleteFunc(resolver.services[service], func(previous string) bool {
+				return previous == key
+			})
+			if len(resolver.services[service]) == 0 {
+				delete(resolver.services, service)
+			}
+			for restartedKey, previous := range resolver.restarted {
+				if previous == key {
+					delete(resolver.restarted, restartedKey)
+				}
+			}
+		}
+		delete(resolver.instances, key)
+		removed++
+	}
+	return removed
+}
+
+func (resolver *InstanceResolver) Size() int {
+	resolver.mutex.RLock()
+	defer resolver.mutex.RUnlock()
+	return len(resolver.instances)
+}
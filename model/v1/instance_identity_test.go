@@ -0,0 +1,125 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestInstanceTrace(timestamp uint64, pod string, containerId string, containerName string, pid uint32) *Trace {
+	return &Trace{
+		Timestamp: timestamp,
+		PodName:   pod,
+		Namespace: "default",
+		Labels: &TraceLabels{
+			ServiceName:   "order",
+			NodeName:      "node-1",
+			NodeIp:        "10.0.0.1",
+			ContainerId:   containerId,
+			ContainerName: containerName,
+			Pid:           pid,
+		},
+	}
+}
+
+func TestInstanceIdentity_GetKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		trace *Trace
+		want  string
+	}{
+		{"pod", newTestInstanceTrace(0, "order-7d9f", "docker://0123456789abcdef", "order", 10), "pod:default/order-7d9f"},
+		{"container name", newTestInstanceTrace(0, "", "0123456789abcdef", "order", 10), "container:node-1/order"},
+		{"container id", newTestInstanceTrace(0, "", "containerd://0123456789ABCDEF", "", 10), "container:node-1/0123456789ab"},
+		{"process", newTestInstanceTrace(0, "", "", "", 10), "process:node-1/order/10"},
+		{"service", newTestInstanceTrace(0, "", "", "", 0), "service:order"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.trace.GetInstanceKey(); got != tt.want {
+				t.Errorf("GetInstanceKey() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInstanceResolver(t *testing.T) {
+	resolver := NewInstanceResolver()
+	resolver.RestartTime = 5
+	// Container of pod restarts with new container id and pid.
+	resolver.Resolve(newTestInstanceTrace(100, "order-7d9f", "docker://aaaaaaaaaaaa1111", "order", 10))
+	resolver.Resolve(newTestInstanceTrace(200, "order-7d9f", "docker://bbbbbbbbbbbb2222", "order", 20))
+	resolver.Resolve(newTestInstanceTrace(150, "order-7d9f", "docker://aaaaaaaaaaaa1111", "order", 10))
+	resolver.Resolve(newTestInstanceTrace(300, "", "", "", 30))
+
+	instance, _ := resolver.GetInstance("pod:default/order-7d9f")
+	if instance.FirstSeen != 100 || instance.LastSeen != 200 || instance.Identity.ContainerId != "bbbbbbbbbbbb" {
+		t.Errorf("GetInstance() = %+v", instance)
+	}
+	if !reflect.DeepEqual(instance.ContainerIds, []string{"aaaaaaaaaaaa", "bbbbbbbbbbbb"}) || !reflect.DeepEqual(instance.Pids, []uint32{10, 20}) {
+		t.Errorf("ContainerIds = %v, Pids = %v", instance.ContainerIds, instance.Pids)
+	}
+
+	if key, _ := resolver.ResolveContainer("aaaaaaaaaaaa1111ffff"); key != "pod:default/order-7d9f" {
+		t.Errorf("ResolveContainer() = %s", key)
+	}
+	if key, _ := resolver.ResolveProcess("node-1", 10); key != "pod:default/order-7d9f" {
+		t.Errorf("ResolveProcess(10) = %s", key)
+	}
+	// Process restarts with new pid.
+	resolver.Resolve(newTestInstanceTrace(310, "", "", "", 31))
+	if key, _ := resolver.ResolveProcess("node-1", 31); key != "process:node-1/order/30" {
+		t.Errorf("ResolveProcess(31) = %s", key)
+	}
+	if !resolver.Matches("process:node-1/order/31", NewInstanceSource("", "node-1", 30)) {
+		t.Errorf("Matches() = false for restarted process")
+	}
+	if !resolver.Matches("pod:default/order-7d9f", NewInstanceSource("docker://aaaaaaaaaaaa1111", "", 0)) {
+		t.Errorf("Matches() = false for container before restart")
+	}
+
+	if removed := resolver.CleanExpired(250); removed != 1 || resolver.Size() != 1 {
+		t.Errorf("CleanExpired() = %d, size = %d", removed, resolver.Size())
+	}
+	if _, found := resolver.ResolveContainer("aaaaaaaaaaaa"); found {
+		t.Errorf("ResolveContainer() finds expired instance")
+	}
+}
+
+func TestInstanceResolver_ProcessReplicas(t *testing.T) {
+	resolver := NewInstanceResolver()
+	resolver.RestartTime = 50
+	// Two replicas of service run on the same node at the same time.
+	resolver.Resolve(newTestInstanceTrace(100, "", "", "", 10))
+	resolver.Resolve(newTestInstanceTrace(110, "", "", "", 20))
+	resolver.Resolve(newTestInstanceTrace(120, "", "", "", 10))
+	if resolver.Size() != 2 {
+		t.Fatalf("Size() = %d, want 2 replicas", resolver.Size())
+	}
+	if resolver.Matches("process:node-1/order/10", NewInstanceSource("", "node-1", 20)) {
+		t.Errorf("Matches() = true for another replica")
+	}
+
+	// Replica 20 is gone and restarts with pid 30, replica 10 is still running.
+	resolver.Resolve(newTestInstanceTrace(160, "", "", "", 10))
+	instance := resolver.Resolve(newTestInstanceTrace(170, "", "", "", 30))
+	if instance.Key != "process:node-1/order/20" || !reflect.DeepEqual(instance.Pids, []uint32{20, 30}) {
+		t.Errorf("Resolve() = %+v, want restart of replica 20", instance)
+	}
+	if !resolver.Matches("process:node-1/order/30", NewInstanceSource("", "node-1", 20)) {
+		t.Errorf("Matches() = false for process before restart")
+	}
+	if key, _ := resolver.ResolveProcess("node-1", 10); key != "process:node-1/order/10" {
+		t.Errorf("ResolveProcess(10) = %s", key)
+	}
+	// Pid 40 starts while both replicas are running.
+	if instance := resolver.Resolve(newTestInstanceTrace(180, "", "", "", 40)); instance.Key != "process:node-1/order/40" {
+		t.Errorf("Resolve() = %s, want new replica", instance.Key)
+	}
+	if resolver.Size() != 3 {
+		t.Errorf("Size() = %d, want 3", resolver.Size())
+	}
+
+	if removed := resolver.CleanExpired(175); removed != 2 || len(resolver.restarted) != 0 || len(resolver.services["node-1/order"]) != 1 {
+		t.Errorf("CleanExpired() = %d, restarted = %v, services = %v", removed, resolver.restarted, resolver.services)
+	}
+}
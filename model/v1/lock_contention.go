@@ -0,0 +1,166 @@
+package model
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+const DefaultTopContendedLocks = 5
+
+// FutexLockEvent is the parsed JavaFutexEvent, a waiter thread blocked on lock held by owner thread.
+type FutexLockEvent struct {
+	Lock         string `json:"lock"`
+	OwnerThread  string `json:"ownerThread"`
+	WaiterThread string `json:"waiterThread"`
+	StartTime    uint64 `json:"startTime"`
+	EndTime      uint64 `json:"endTime"`
+	WaitTime     uint64 `json:"waitTime"`
+}
+
+// FutexDataParser returns the lock identity and owner thread of DataVal, owner is empty if unknown.
+type FutexDataParser func(dataVal string) (lock string, owner string)
+
+// ParseFutexDataVal reads DataVal as json object {"lock": "java.util.HashMap@1a2b", "owner": "exec-2"},
+// DataVal which is not such object is taken as lock identity without owner.
+// Replace it when the agent reports DataVal in another format.
+var ParseFutexDataVal FutexDataParser = func(dataVal string) (string, string) {
+	dataVal = strings.TrimSpace(dataVal)
+	if strings.HasPrefix(dataVal, "{") {
+		var payload struct {
+			Lock  string `json:"lock"`
+			Owner string `json:"owner"`
+		}
+		if err := json.Unmarshal([]byte(dataVal), &payload); err == nil && payload.Lock != "" {
+			return strings.TrimSpace(payload.Lock), strings.TrimSpace(payload.Owner)
+		}
+	}
+	return dataVal, ""
+}
+
+// ParseJavaFutexEvent reads lock and owner from DataVal by ParseFutexDataVal,
+// waiter is the thread of profiles which the event is collected from.
+func ParseJavaFutexEvent(event *JavaFutexEvent, threadName string) *FutexLockEvent {
+	lock, owner := ParseFutexDataVal(event.DataVal)
+	lockEvent := &FutexLockEvent{
+		Lock:         lock,
+		OwnerThread:  owner,
+		WaiterThread: threadName,
+		StartTime:    event.StartTime,
+		EndTime:      event.EndTime,
+	}
+	if lockEvent.Lock == "" {
+		lockEvent.Lock = "unknown"
+	}
+	if event.EndTime > event.StartTime {
+		lockEvent.WaitTime = event.EndTime - event.StartTime
+	}
+	return lockEvent
+}
+
+type LockContention struct {
+	Lock          string   `json:"lock"`
+	WaitCount     int      `json:"waitCount"`
+	TotalWaitTime uint64   `json:"totalWaitTime"`
+	MaxWaitTime   uint64   `json:"maxWaitTime"`
+	Owners        []string `json:"owners"`
+	Waiters       []string `json:"waiters"`
+}
+
+type LockContentionSummary struct {
+	StartTime uint64 `json:"startTime"`
+	EndTime   uint64 `json:"endTime"`
+	LockCount int    `json:"lockCount"`
+	// Sum of the blocked time of each thread, overlapped waits of same thread are counted once.
+	TotalBlockedTime uint64 `json:"totalBlockedTime"`
+	// Sum of the profile window of each thread.
+	ThreadTime uint64 `json:"threadTime"`
+	// TotalBlockedTime / ThreadTime, no more than 100.
+	BlockedPercent float64           `json:"blockedPercent"`
+	TopLocks       []*LockContention `json:"topLocks"`
+}
+
+// NewLockContentionSummary aggregates futex events of profiles by lock, wait time is clipped to the window of each profiles.
+func NewLockContentionSummary(profilesList []*Profiles, topN int) *LockContentionSummary {
+	if topN <= 0 {
+		topN = DefaultTopContendedLocks
+	}
+	summary := &LockContentionSummary{TopLocks: make([]*LockContention, 0)}
+	contentions := make([]*LockContention, 0)
+	contentionMap := make(map[string]*LockContention)
+	for _, profiles := range profilesList {
+		if summary.StartTime == 0 || profiles.StartTime < summary.StartTime {
+			summary.StartTime = profiles.StartTime
+		}
+		if profiles.EndTime > summary.EndTime {
+			summary.EndTime = profiles.EndTime
+		}
+		if profiles.EndTime > profiles.StartTime {
+			summary.ThreadTime += profiles.EndTime - profiles.StartTime
+		}
+
+		waits := make([]*FutexLockEvent, 0, len(profiles.overlappedFutexs))
+		for _, event := range profiles.overlappedFutexs {
+			lockEvent := ParseJavaFutexEvent(event, profiles.ThreadName)
+			waitTime := profiles.clip(lockEvent.StartTime, lockEvent.EndTime)
+			if waitTime == 0 {
+				continue
+			}
+			waits = append(waits, lockEvent)
+			contention, exist := contentionMap[lockEvent.Lock]
+			if !exist {
+				contention = &LockContention{Lock: lockEvent.Lock, Owners: make([]string, 0), Waiters: make([]string, 0)}
+				contentionMap[lockEvent.Lock] = contention
+				contentions = append(contentions, contention)
+			}
+			contention.WaitCount++
+			contention.TotalWaitTime += waitTime
+			if waitTime > contention.MaxWaitTime {
+				contention.MaxWaitTime = waitTime
+			}
+			contention.Owners = appendIfMissing(contention.Owners, lockEvent.OwnerThread)
+			contention.Waiters = appendIfMissing(contention.Waiters, lockEvent.WaiterThread)
+		}
+		summary.TotalBlockedTime += profiles.blockedTime(waits)
+	}
+
+	sort.SliceStable(contentions, func(i, j int) bool {
+		return contentions[i].TotalWaitTime > contentions[j].TotalWaitTime
+	})
+	if len(contentions) > topN {
+		summary.TopLocks = contentions[:topN]
+	} else {
+		summary.TopLocks = contentions
+	}
+	summary.LockCount = len(contentions)
+	if summary.ThreadTime > 0 {
+		summary.BlockedPercent = float64(summary.TotalBlockedTime) * 100 / float64(summary.ThreadTime)
+	}
+	return summary
+}
+
+// blockedTime returns the union of waits clipped to profile window.
+func (p *Profiles) blockedTime(waits []*FutexLockEvent) uint64 {
+	sort.Slice(waits, func(i, j int) bool {
+		return waits[i].StartTime < waits[j].StartTime
+	})
+	var blockedTime, blockedEndTime uint64
+	for _, wait := range waits {
+		startTime := max(wait.StartTime, blockedEndTime)
+		blockedTime += p.clip(startTime, wait.EndTime)
+		blockedEndTime = max(blockedEndTime, wait.EndTime)
+	}
+	return blockedTime
+}
+
+func appendIfMissing(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,74 @@
+package model
+
+import "testing"
+
+func TestNewLockContentionSummary(t *testing.T) {
+	profiles := NewProfiles(1000, 2000)
+	profiles.ThreadName = "exec-1"
+	profiles.AddFutexEvents([]*JavaFutexEvent{
+		{StartTime: 1100, EndTime: 1300, DataVal: `{"lock": "java.util.HashMap@1a2b", "owner": "exec-2"}`},
+		{StartTime: 1400, EndTime: 1500, DataVal: " java.util.HashMap@1a2b "},
+		// Partially in window, 100ns is counted.
+		{StartTime: 1900, EndTime: 2100, DataVal: "0x7f00aa"},
+		{StartTime: 2100, EndTime: 2200, DataVal: "0x7f00aa"},
+	})
+	if len(profiles.Futexs) != 2 {
+		t.Errorf("futexs = %d, want only events inside window", len(profiles.Futexs))
+	}
+
+	summary := NewLockContentionSummary([]*Profiles{profiles}, 1)
+	if summary.LockCount != 2 || summary.TotalBlockedTime != 400 || summary.BlockedPercent != 40 || len(summary.TopLocks) != 1 {
+		t.Fatalf("summary = %+v", summary)
+	}
+	top := summary.TopLocks[0]
+	if top.Lock != "java.util.HashMap@1a2b" || top.WaitCount != 2 || top.TotalWaitTime != 300 || top.MaxWaitTime != 200 {
+		t.Errorf("top lock = %+v", top)
+	}
+	if len(top.Waiters) != 1 || top.Waiters[0] != "exec-1" {
+		t.Errorf("waiters = %v", top.Waiters)
+	}
+	if len(top.Owners) != 1 || top.Owners[0] != "exec-2" {
+		t.Errorf("owners = %v", top.Owners)
+	}
+}
+
+func TestParseJavaFutexEvent(t *testing.T) {
+	tests := []struct {
+		dataVal   string
+		wantLock  string
+		wantOwner string
+	}{
+		{`{"lock": "java.util.HashMap@1a2b", "owner": "exec-2"}`, "java.util.HashMap@1a2b", "exec-2"},
+		{`{"lock": "0x7f00aa"}`, "0x7f00aa", ""},
+		{" 0x7f00aa ", "0x7f00aa", ""},
+		{`{"owner": "exec-2"}`, `{"owner": "exec-2"}`, ""},
+		{"", "unknown", ""},
+	}
+	for _, tt := range tests {
+		event := ParseJavaFutexEvent(&JavaFutexEvent{StartTime: 100, EndTime: 300, DataVal: tt.dataVal}, "exec-1")
+		if event.Lock != tt.wantLock || event.OwnerThread != tt.wantOwner || event.WaiterThread != "exec-1" || event.WaitTime != 200 {
+			t.Errorf("ParseJavaFutexEvent(%q) = %+v", tt.dataVal, event)
+		}
+	}
+}
+
+func TestLockContentionSummary_BlockedPercent(t *testing.T) {
+	// Two threads are both blocked in the whole window, and the waits of exec-2 overlap.
+	thread1 := NewProfiles(1000, 2000)
+	thread1.ThreadName = "exec-1"
+	thread1.AddFutexEvents([]*JavaFutexEvent{{StartTime: 1000, EndTime: 2000, DataVal: "0x1"}})
+	thread2 := NewProfiles(1000, 2000)
+	thread2.ThreadName = "exec-2"
+	thread2.AddFutexEvents([]*JavaFutexEvent{
+		{StartTime: 900, EndTime: 1600, DataVal: "0x1"},
+		{StartTime: 1500, EndTime: 2000, DataVal: "0x2"},
+	})
+
+	summary := NewLockContentionSummary([]*Profiles{thread1, thread2}, 0)
+	if summary.ThreadTime != 2000 || summary.TotalBlockedTime != 2000 || summary.BlockedPercent != 100 {
+		t.Errorf("summary = %+v", summary)
+	}
+	if top := summary.TopLocks[0]; top.Lock != "0x1" || top.TotalWaitTime != 1600 || len(top.Waiters) != 2 {
+		t.Errorf("top lock = %+v", top)
+	}
+}
@@ -1,5 +1,7 @@
 package model
 
+import "sort"
+
 type LogEvents struct {
 	StartTime uint64
 	EndTime   uint64
@@ -14,7 +16,39 @@ func NewLogEvents(startTime uint64, endTime uint64) *LogEvents {
 	}
 }
 
+// AddLogEvent adds event to its thread if event is in [StartTime, EndTime].
+func (events *LogEvents) AddLogEvent(event *LogEvent) bool {
+	if event.Timestamp < events.StartTime || event.Timestamp > events.EndTime {
+		return false
+	}
+	events.Logs[event.Tid] = append(events.Logs[event.Tid], event)
+	return true
+}
+
+func (events *LogEvents) Size() int {
+	size := 0
+	for _, threadEvents := range events.Logs {
+		size += len(threadEvents)
+	}
+	return size
+}
+
+// GetLogs splits the logs of each thread ordered by timestamp.
+func (events *LogEvents) GetLogs() *Logs {
+	logs := newLogs()
+	for tid, threadEvents := range events.Logs {
+		sort.SliceStable(threadEvents, func(i, j int) bool {
+			return threadEvents[i].Timestamp < threadEvents[j].Timestamp
+		})
+		for _, event := range threadEvents {
+			logs.addLog(tid, event.ThreadName, event.Logs)
+		}
+	}
+	return logs
+}
+
 type LogEvent struct {
+	Timestamp   uint64 `json:"timestamp"`
 	Pid         uint64 `json:"pid"`
 	Tid         uint64 `json:"tid"`
 	ThreadName  string `json:"threadName"`
@@ -23,3 +57,25 @@ type LogEvent struct {
 	NodeIp      string `json:"node_ip"`
 	Logs        string `json:"logs"`
 }
+
+func NewLogEvent(group *CameraLogGroup) *LogEvent {
+	return &LogEvent{
+		Timestamp:   uint64(group.Timestamp),
+		Pid:         group.Labels.Pid,
+		Tid:         group.Labels.Tid,
+		ThreadName:  group.Labels.ThreadName,
+		ContainerId: group.Labels.ContainerId,
+		NodeName:    group.Labels.NodeName,
+		NodeIp:      group.Labels.NodeIp,
+		Logs:        group.Labels.Logs,
+	}
+}
+
+// GetSource returns the container or process which the log is collected from, node is node name or node ip as in traces.
+func (event *LogEvent) GetSource() InstanceSource {
+	node := event.NodeName
+	if node == "" {
+		node = event.NodeIp
+	}
+	return NewInstanceSource(event.ContainerId, node, uint32(event.Pid))
+}
@@ -0,0 +1,327 @@
+package model
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type LogFormat string
+
+const (
+	LogFormatLogback LogFormat = "logback"
+	LogFormatSpring  LogFormat = "spring"
+	LogFormatJSON    LogFormat = "json"
+	LogFormatLogfmt  LogFormat = "logfmt"
+	LogFormatGo      LogFormat = "go"
+	LogFormatPython  LogFormat = "python"
+	// Other log starting with time and level.
+	LogFormatText LogFormat = "text"
+	LogFormatRaw  LogFormat = "raw"
+)
+
+// LogRecord is a parsed log, continuation lines like stack trace are kept in Stack.
+// Lines matching no format are LogFormatRaw records with the line as Message.
+type LogRecord struct {
+	Timestamp  uint64    `json:"timestamp"` // ns, 0 if not found
+	Level      string    `json:"level"`
+	Logger     string    `json:"logger"`
+	Thread     string    `json:"thread"`
+	Message    string    `json:"message"`
+	Stack      string    `json:"stack,omitempty"`
+	TraceId    string    `json:"traceId,omitempty"`
+	SpanId     string    `json:"spanId,omitempty"`
+	Format     LogFormat `json:"format"`
+	Tid        uint64    `json:"tid,omitempty"`
+	ThreadName string    `json:"threadName,omitempty"`
+}
+
+const (
+	logTimePattern  = `(\d{4}-\d{2}-\d{2}[ T]\d{2}:\d{2}:\d{2}(?:[.,]\d{1,9})?(?:Z|[+-]\d{2}:?\d{2})?)`
+	logLevelPattern = `(TRACE|DEBUG|INFO|NOTICE|WARN|WARNING|ERROR|SEVERE|FATAL|CRITICAL)`
+)
+
+var (
+	// 2023-11-07 17:18:56.607 [main] INFO com.example.App - message
+	logbackThreadFirstRegex = regexp.MustCompile(`^` + logTimePattern + `\s+\[([^\]]*)\]\s+` + logLevelPattern + `\s+(\S+)\s+-\s?(.*)$`)
+	// 2023-11-07 17:18:56,607 ERROR com.example.App [main] message
+	logbackLevelFirstRegex = regexp.MustCompile(`^` + logTimePattern + `\s+` + logLevelPattern + `\s+(\S+)\s+\[([^\]]*)\]\s?(.*)$`)
+	// 2023-11-07 17:18:56.607  INFO 1234 --- [main] com.example.App : message
+	springRegex = regexp.MustCompile(`^` + logTimePattern + `\s+` + logLevelPattern + `\s+\d+\s+---\s+\[\s*([^\]]*)\]\s+(\S+)\s+:\s?(.*)$`)
+	// 2023-11-07 17:18:56,607 - name - INFO - message
+	pythonRegex = regexp.MustCompile(`^` + logTimePattern + `\s+-\s+(\S+)\s+-\s+` + logLevelPattern + `\s+-\s?(.*)$`)
+	// INFO:root:message
+	pythonDefaultRegex = regexp.MustCompile(`^` + logLevelPattern + `:([^:\s]+):(.*)$`)
+	// 2009/11/10 23:00:00.000000 message
+	goRegex = regexp.MustCompile(`^(\d{4}/\d{2}/\d{2} \d{2}:\d{2}:\d{2}(?:\.\d{1,9})?)\s(.*)$`)
+	// Fallback to any log starting with time.
+	timeLevelRegex = regexp.MustCompile(`^` + logTimePattern + `\s+\[?` + logLevelPattern + `\]?\s+(.*)$`)
+
+	logfmtRegex = regexp.MustCompile(`(\w[\w.\-]*)=("(?:[^"\\]|\\.)*"|\S*)`)
+	// TID is only accepted in the SkyWalking form "[TID:xxx]", a bare tid is usually a thread id.
+	logTraceIdRegex = regexp.MustCompile(`(?i:\btrace[_.\-]?id[=:\s]\s*\[?([0-9a-fA-F][0-9a-fA-F.\-]{7,}))|\[TID:([0-9a-fA-F][0-9a-fA-F.\-]{7,})\]`)
+	logSpanIdRegex  = regexp.MustCompile(`(?i)\bspan[_.\-]?id[=:\s]\s*\[?([0-9a-fA-F][0-9a-fA-F\-]{7,})`)
+	// Lines of stack trace which never start a log, include the java exception line, eg. "java.lang.IllegalStateException: message".
+	logContinuationRegex = regexp.MustCompile(`^(\s+|at\s|Caused by:|\.\.\. \d+ more|Traceback |goroutine \d+ \[|[\w$]+(\.[\w$]+)+(Exception|Error|Throwable)(:|$))`)
+)
+
+var logTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999",
+	"2006/01/02 15:04:05.999999999",
+}
+
+var (
+	jsonTimeKeys    = []string{"@timestamp", "timestamp", "time", "ts", "date"}
+	jsonLevelKeys   = []string{"level", "severity", "lvl", "log.level", "levelname"}
+	jsonLoggerKeys  = []string{"logger", "logger_name", "loggerName", "log.logger", "name"}
+	jsonThreadKeys  = []string{"thread", "thread_name", "threadName", "process.thread.name"}
+	jsonMessageKeys = []string{"message", "msg", "log"}
+	jsonTraceKeys   = []string{"trace_id", "traceId", "traceID", "trace.id", "dd.trace_id"}
+	jsonSpanKeys    = []string{"span_id", "spanId", "spanID", "span.id", "dd.span_id"}
+	jsonStackKeys   = []string{"stack_trace", "stacktrace", "stack", "exception", "error.stack_trace", "exc_info"}
+)
+
+type LogParser struct {
+	// Location of the time without zone.
+	loc *time.Location
+}
+
+func NewLogParser(loc *time.Location) *LogParser {
+	if loc == nil {
+		loc = time.Local
+	}
+	return &LogParser{loc: loc}
+}
+
+// ParseLogText parses the "len@content|" framed logs of agent, records before the broken frame are returned with error.
+func (p *LogParser) ParseLogText(logText string) ([]*LogRecord, error) {
+	logs, err := DecodeLogFrames(logText)
+	return p.Parse(logs), err
+}
+
+// ParseLogEvent parses the logs of event, thread is filled by event if not found in log.
+func (p *LogParser) ParseLogEvent(event *LogEvent) ([]*LogRecord, error) {
+	records, err := p.ParseLogText(event.Logs)
+	for _, record := range records {
+		record.Tid = event.Tid
+		record.ThreadName = event.ThreadName
+		if record.Thread == "" {
+			record.Thread = event.ThreadName
+		}
+	}
+	return records, err
+}
+
+// Parse parses logs and groups continuation lines (eg. stack trace) into previous record,
+// other lines matching no format are kept as separate raw records.
+func (p *LogParser) Parse(logs []string) []*LogRecord {
+	records := make([]*LogRecord, 0)
+	var last *LogRecord
+	for _, log := range logs {
+		for _, line := range strings.Split(strings.ReplaceAll(log, "\r\n", "\n"), "\n") {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			record := p.ParseLine(line)
+			if record.Format == LogFormatRaw && last != nil && logContinuationRegex.MatchString(line) {
+				if last.Stack == "" {
+					last.Stack = line
+				} else {
+					last.Stack += "\n" + line
+				}
+				if last.TraceId == "" {
+					last.TraceId, last.SpanId = findLogTraceIds(line)
+				}
+				continue
+			}
+			records = append(records, record)
+			last = record
+		}
+	}
+	return records
+}
+
+// ParseLine parses one line, Format is LogFormatRaw if no format is matched.
+func (p *LogParser) ParseLine(line string) *LogRecord {
+	trimmed := strings.TrimSpace(line)
+	var record *LogRecord
+	if strings.HasPrefix(trimmed, "{") {
+		record = p.parseJSON(trimmed)
+	}
+	if record == nil {
+		record = p.parseText(line)
+	}
+	if record == nil {
+		record = p.parseLogfmt(trimmed)
+	}
+	if record == nil {
+		return &LogRecord{Format: LogFormatRaw, Message: line}
+	}
+	record.Level = normalizeLogLevel(record.Level)
+	if record.TraceId == "" {
+		record.TraceId, record.SpanId = findLogTraceIds(line)
+	}
+	return record
+}
+
+func (p *LogParser) parseText(line string) *LogRecord {
+	if matches := logbackThreadFirstRegex.FindStringSubmatch(line); matches != nil {
+		return &LogRecord{Format: LogFormatLogback, Timestamp: p.parseTime(matches[1]), Thread: matches[2], Level: matches[3], Logger: matches[4], Message: matches[5]}
+	}
+	if matches := springRegex.FindStringSubmatch(line); matches != nil {
+		return &LogRecord{Format: LogFormatSpring, Timestamp: p.parseTime(matches[1]), Level: matches[2], Thread: strings.TrimSpace(matches[3]), Logger: matches[4], Message: matches[5]}
+	}
+	if matches := logbackLevelFirstRegex.FindStringSubmatch(line); matches != nil {
+		return &LogRecord{Format: LogFormatLogback, Timestamp: p.parseTime(matches[1]), Level: matches[2], Logger: matches[3], Thread: matches[4], Message: matches[5]}
+	}
+	if matches := pythonRegex.FindStringSubmatch(line); matches != nil {
+		return &LogRecord{Format: LogFormatPython, Timestamp: p.parseTime(matches[1]), Logger: matches[2], Level: matches[3], Message: matches[4]}
+	}
+	if matches := pythonDefaultRegex.FindStringSubmatch(line); matches != nil {
+		return &LogRecord{Format: LogFormatPython, Level: matches[1], Logger: matches[2], Message: matches[3]}
+	}
+	if matches := goRegex.FindStringSubmatch(line); matches != nil {
+		return &LogRecord{Format: LogFormatGo, Timestamp: p.parseTime(matches[1]), Message: matches[2]}
+	}
+	if matches := timeLevelRegex.FindStringSubmatch(line); matches != nil {
+		return &LogRecord{Format: LogFormatText, Timestamp: p.parseTime(matches[1]), Level: matches[2], Message: matches[3]}
+	}
+	return nil
+}
+
+func (p *LogParser) parseJSON(line string) *LogRecord {
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &values); err != nil {
+		return nil
+	}
+	record := &LogRecord{Format: LogFormatJSON}
+	record.Timestamp = p.parseJSONTime(findLogValue(values, jsonTimeKeys))
+	record.Level = normalizeLogLevel(stringLogValue(findLogValue(values, jsonLevelKeys)))
+	record.Logger = stringLogValue(findLogValue(values, jsonLoggerKeys))
+	record.Thread = stringLogValue(findLogValue(values, jsonThreadKeys))
+	record.Message = stringLogValue(findLogValue(values, jsonMessageKeys))
+	record.TraceId = stringLogValue(findLogValue(values, jsonTraceKeys))
+	record.SpanId = stringLogValue(findLogValue(values, jsonSpanKeys))
+	record.Stack = stringLogValue(findLogValue(values, jsonStackKeys))
+	if record.TraceId == "" {
+		record.TraceId, record.SpanId = findLogTraceIds(record.Message)
+	}
+	return record
+}
+
+func (p *LogParser) parseLogfmt(line string) *LogRecord {
+	if !strings.Contains(line, "msg=") && !strings.Contains(line, "message=") {
+		return nil
+	}
+	values := make(map[string]interface{})
+	for _, matches := range logfmtRegex.FindAllStringSubmatch(line, -1) {
+		value := matches[2]
+		if strings.HasPrefix(value, `"`) {
+			var unquoted string
+			if err := json.Unmarshal([]byte(value), &unquoted); err == nil {
+				value = unquoted
+			}
+		}
+		values[matches[1]] = value
+	}
+	record := &LogRecord{Format: LogFormatLogfmt}
+	record.Timestamp = p.parseJSONTime(findLogValue(values, jsonTimeKeys))
+	record.Level = stringLogValue(findLogValue(values, jsonLevelKeys))
+	record.Logger = stringLogValue(findLogValue(values, jsonLoggerKeys))
+	record.Thread = stringLogValue(findLogValue(values, jsonThreadKeys))
+	record.Message = stringLogValue(findLogValue(values, jsonMessageKeys))
+	record.TraceId = stringLogValue(findLogValue(values, jsonTraceKeys))
+	record.SpanId = stringLogValue(findLogValue(values, jsonSpanKeys))
+	return record
+}
+
+func (p *LogParser) parseTime(value string) uint64 {
+	value = strings.Replace(value, ",", ".", 1)
+	value = strings.Replace(value, "T", " ", 1)
+	for _, layout := range logTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, p.loc); err == nil {
+			return uint64(t.UnixNano())
+		}
+	}
+	return 0
+}
+
+// parseJSONTime parses time string or epoch in s, ms, us or ns.
+func (p *LogParser) parseJSONTime(value interface{}) uint64 {
+	switch v := value.(type) {
+	case string:
+		if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			return uint64(t.UnixNano())
+		}
+		return p.parseTime(v)
+	case float64:
+		switch {
+		case v > 1e17:
+			return uint64(v)
+		case v > 1e14:
+			return uint64(v * 1e3)
+		case v > 1e11:
+			return uint64(v * 1e6)
+		case v > 0:
+			return uint64(v * 1e9)
+		}
+	}
+	return 0
+}
+
+func findLogValue(values map[string]interface{}, keys []string) interface{} {
+	for _, key := range keys {
+		if value, exist := values[key]; exist && value != nil {
+			return value
+		}
+	}
+	// Nested object, eg. {"log": {"level": "info"}}.
+	for _, key := range keys {
+		if index := strings.Index(key, "."); index > 0 {
+			if nested, ok := values[key[:index]].(map[string]interface{}); ok {
+				if value := findLogValue(nested, []string{key[index+1:]}); value != nil {
+					return value
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func stringLogValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+func findLogTraceIds(text string) (traceId string, spanId string) {
+	if matches := logTraceIdRegex.FindStringSubmatch(text); matches != nil {
+		traceId = matches[1] + matches[2]
+	}
+	if matches := logSpanIdRegex.FindStringSubmatch(text); matches != nil {
+		spanId = matches[1]
+	}
+	return traceId, spanId
+}
+
+func normalizeLogLevel(level string) string {
+	switch level = strings.ToUpper(strings.TrimSpace(level)); level {
+	case "WARNING":
+		return "WARN"
+	case "SEVERE":
+		return "ERROR"
+	case "CRITICAL":
+		return "FATAL"
+	default:
+		return level
+	}
+}
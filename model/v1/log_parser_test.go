@@ -0,0 +1,96 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDecodeLogFrames(t *testing.T) {
+	tests := []struct {
+		name    string
+		logText string
+		want    []string
+		wantErr bool
+	}{
+		{"bytes", "9@日志abc|2@ok|", []string{"日志abc", "ok"}, false},
+		{"br", "6@a<br>b|", []string{"a", "b"}, false},
+		{"cut in rune", "5@日\xe5\xbf|", []string{"日"}, false},
+		// Length in runes or UTF-16 units is not written by agent.
+		{"runes", "2@ok|5@日志abc|", []string{"ok"}, true},
+		{"utf16 units", "3@😀a|", []string{}, true},
+		{"too long", "10@abc|", []string{}, true},
+		{"no length", "abc|", []string{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeLogFrames(tt.logText)
+			if !reflect.DeepEqual(got, tt.want) || (err != nil) != tt.wantErr {
+				t.Errorf("DecodeLogFrames() = %q, %v, want %q, error %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLogParser_Parse(t *testing.T) {
+	parser := NewLogParser(time.UTC)
+	records := parser.Parse([]string{
+		"2023-11-07 17:18:56,607 ERROR org.apache.juli.logging.DirectJDKLog [http-nio-19999-exec-1] Servlet.service() threw exception\norg.springframework.web.client.HttpServerErrorException: 500 null\n\tat org.springframework.web.client.DefaultResponseErrorHandler.handleError(DefaultResponseErrorHandler.java:97)",
+		"2023-11-07 17:18:57.000 [main] INFO com.example.App - [TID:3f2a9c1e.58.16993] order created",
+		"2023-11-07 17:18:58.123  WARN 1234 --- [  exec-2] c.e.OrderService : slow query",
+		`{"@timestamp":"2023-11-07T17:18:59.5Z","level":"info","logger_name":"app","thread_name":"t1","message":"hello","trace_id":"abcdef0123456789"}`,
+		"time=2023-11-07T17:19:00Z level=WARNING msg=\"disk full\" span_id=0123456789abcdef",
+		"2023/11/07 17:19:01 go log",
+		"ERROR:root:python error",
+		"Traceback (most recent call last):",
+		`  File "app.py", line 1, in <module>`,
+		"plain output",
+		"another output",
+	})
+
+	want := []*LogRecord{
+		{Format: LogFormatLogback, Timestamp: 1699377536607000000, Level: "ERROR", Logger: "org.apache.juli.logging.DirectJDKLog", Thread: "http-nio-19999-exec-1", Message: "Servlet.service() threw exception",
+			Stack: "org.springframework.web.client.HttpServerErrorException: 500 null\n\tat org.springframework.web.client.DefaultResponseErrorHandler.handleError(DefaultResponseErrorHandler.java:97)"},
+		{Format: LogFormatLogback, Timestamp: 1699377537000000000, Level: "INFO", Logger: "com.example.App", Thread: "main", Message: "[TID:3f2a9c1e.58.16993] order created", TraceId: "3f2a9c1e.58.16993"},
+		{Format: LogFormatSpring, Timestamp: 1699377538123000000, Level: "WARN", Logger: "c.e.OrderService", Thread: "exec-2", Message: "slow query"},
+		{Format: LogFormatJSON, Timestamp: 1699377539500000000, Level: "INFO", Logger: "app", Thread: "t1", Message: "hello", TraceId: "abcdef0123456789"},
+		{Format: LogFormatLogfmt, Timestamp: 1699377540000000000, Level: "WARN", Message: "disk full", SpanId: "0123456789abcdef"},
+		{Format: LogFormatGo, Timestamp: 1699377541000000000, Message: "go log"},
+		{Format: LogFormatPython, Level: "ERROR", Logger: "root", Message: "python error",
+			Stack: "Traceback (most recent call last):\n  File \"app.py\", line 1, in <module>"},
+		{Format: LogFormatRaw, Message: "plain output"},
+		{Format: LogFormatRaw, Message: "another output"},
+	}
+	if len(records) != len(want) {
+		t.Fatalf("Parse() returns %d records, want %d", len(records), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(records[i], want[i]) {
+			t.Errorf("Parse()[%d] = %+v, want %+v", i, records[i], want[i])
+		}
+	}
+}
+
+func TestFindLogTraceIds(t *testing.T) {
+	tests := []struct {
+		text    string
+		traceId string
+	}{
+		{text: "[TID:3f2a9c1e.58.16993] order created", traceId: "3f2a9c1e.58.16993"},
+		{text: "traceId=abcdef0123456789 done", traceId: "abcdef0123456789"},
+		{text: "TRACE_ID: [abcdef0123456789]", traceId: "abcdef0123456789"},
+		{text: "tid=12345678 lock acquired"},
+		{text: "TID:12345678 lock acquired"},
+		{text: "[tid:12345678] lock acquired"},
+	}
+	for _, tt := range tests {
+		if traceId, _ := findLogTraceIds(tt.text); traceId != tt.traceId {
+			t.Errorf("findLogTraceIds(%q) = %q, want %q", tt.text, traceId, tt.traceId)
+		}
+	}
+
+	record := NewLogParser(time.UTC).Parse([]string{`{"message":"hello","tid":"12345678"}`})[0]
+	if record.TraceId != "" {
+		t.Errorf("json tid is parsed as traceId %q", record.TraceId)
+	}
+}
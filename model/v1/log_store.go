@@ -0,0 +1,187 @@
+package model
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// LogStore indexes CameraLogGroup by container, pid, tid and timestamp, logs older than cacheTime seconds are evicted.
+// With InstanceResolver, logs of tree node are queried by its stable instance key, so logs before restart are included.
+type LogStore struct {
+	cacheTime int64
+	now       func() uint64
+	resolver  *InstanceResolver
+
+	mutex     sync.RWMutex
+	instances map[InstanceSource]map[uint64][]*LogEvent // <source, <tid, events sorted by timestamp>>
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func NewLogStore(cacheTime int64) *LogStore {
+	return &LogStore{
+		cacheTime: cacheTime,
+		now:       func() uint64 { return uint64(time.Now().UnixNano()) },
+		instances: make(map[InstanceSource]map[uint64][]*LogEvent),
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (store *LogStore) SetInstanceResolver(resolver *InstanceResolver) {
+	store.resolver = resolver
+}
+
+func (store *LogStore) AddLogGroup(group *CameraLogGroup) bool {
+	if group == nil || group.Labels.Logs == "" {
+		return false
+	}
+	store.AddLogEvent(NewLogEvent(group))
+	return true
+}
+
+func (store *LogStore) AddLogEvent(event *LogEvent) {
+	key := event.GetSource()
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	threads, exist := store.instances[key]
+	if !exist {
+		threads = make(map[uint64][]*LogEvent)
+		store.instances[key] = threads
+	}
+	events := threads[event.Tid]
+	// Logs mostly arrive in order, insert the delayed one by binary search.
+	index := sort.Search(len(events), func(i int) bool {
+		return events[i].Timestamp > event.Timestamp
+	})
+	if index == len(events) {
+		threads[event.Tid] = append(events, event)
+		return
+	}
+	events = append(events, nil)
+	copy(events[index+1:], events[index:])
+	events[index] = event
+	threads[event.Tid] = events
+}
+
+// QueryLogEvents returns the logs of container and pid's threads between startTime and endTime.
+func (store *LogStore) QueryLogEvents(containerId string, pid uint64, startTime uint64, endTime uint64) *LogEvents {
+	containerId = NormalizeContainerId(containerId)
+	return store.queryLogEvents(func(source InstanceSource) bool {
+		return source.ContainerId == containerId && uint64(source.Pid) == pid
+	}, startTime, endTime)
+}
+
+// QueryInstanceLogEvents returns the logs of all containers and processes resolved to instanceKey between startTime and endTime.
+func (store *LogStore) QueryInstanceLogEvents(instanceKey string, startTime uint64, endTime uint64) *LogEvents {
+	if store.resolver == nil {
+		return NewLogEvents(startTime, endTime)
+	}
+	return store.queryLogEvents(func(source InstanceSource) bool {
+		return store.resolver.Matches(instanceKey, source)
+	}, startTime, endTime)
+}
+
+func (store *LogStore) queryLogEvents(match func(source InstanceSource) bool, startTime uint64, endTime uint64) *LogEvents {
+	result := NewLogEvents(startTime, endTime)
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	for source, threads := range store.instances {
+		if !match(source) {
+			continue
+		}
+		for tid, events := range threads {
+			from := sort.Search(len(events), func(i int) bool {
+				return events[i].Timestamp >= startTime
+			})
+			for i := from; i < len(events) && events[i].Timestamp <= endTime; i++ {
+				result.Logs[tid] = append(result.Logs[tid], events[i])
+			}
+		}
+	}
+	return result
+}
+
+func (store *LogStore) QueryLogs(containerId string, pid uint64, startTime uint64, endTime uint64) *Logs {
+	return store.QueryLogEvents(containerId, pid, startTime, endTime).GetLogs()
+}
+
+func (store *LogStore) GetTraceNodeLogs(node *TraceTreeNode) *Logs {
+	return store.getNodeLogs(node.InstanceKey, node.ContainerId, node.Pid, node.StartTime, node.StartTime+node.TotalTime)
+}
+
+func (store *LogStore) GetErrorNodeLogs(node *ErrorTreeNode) *Logs {
+	return store.getNodeLogs(node.InstanceKey, node.ContainerId, node.Pid, node.StartTime, node.StartTime+node.TotalTime)
+}
+
+// getNodeLogs queries by instanceKey when it is resolved, otherwise by container and pid of node.
+func (store *LogStore) getNodeLogs(instanceKey string, containerId string, pid uint32, startTime uint64, endTime uint64) *Logs {
+	if store.resolver != nil && instanceKey != "" {
+		if _, exist := store.resolver.GetInstance(instanceKey); exist {
+			return store.QueryInstanceLogEvents(instanceKey, startTime, endTime).GetLogs()
+		}
+	}
+	return store.QueryLogs(containerId, uint64(pid), startTime, endTime)
+}
+
+// CleanExpired removes logs older than cacheTime, returns the number of evicted logs.
+func (store *LogStore) CleanExpired() int {
+	expireTime := store.now() - uint64(store.cacheTime)*uint64(time.Second)
+	evicted := 0
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	for key, threads := range store.instances {
+		for tid, events := range threads {
+			index := sort.Search(len(events), func(i int) bool {
+				return events[i].Timestamp >= expireTime
+			})
+			if index == len(events) {
+				delete(threads, tid)
+			} else if index > 0 {
+				threads[tid] = append(events[:0:0], events[index:]...)
+			}
+			evicted += index
+		}
+		if len(threads) == 0 {
+			delete(store.instances, key)
+		}
+	}
+	return evicted
+}
+
+// Start cleans expired logs every interval until Stop.
+func (store *LogStore) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				store.CleanExpired()
+			case <-store.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (store *LogStore) Stop() {
+	store.stopOnce.Do(func() {
+		close(store.stopCh)
+	})
+}
+
+func (store *LogStore) Size() int {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+	size := 0
+	for _, threads := range store.instances {
+		for _, events := range threads {
+			size += len(events)
+		}
+	}
+	return size
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestLogGroup(containerId string, pid uint64, tid uint64, timestamp int64, logs string) *CameraLogGroup {
+	return &CameraLogGroup{
+		Name:      "camera_log_event",
+		Timestamp: timestamp,
+		Labels: CameraLogLabel{
+			ContainerId: containerId,
+			Pid:         pid,
+			Tid:         tid,
+			ThreadName:  "exec-1",
+			Logs:        logs,
+		},
+	}
+}
+
+func TestLogStore_Query(t *testing.T) {
+	store := NewLogStore(60)
+	store.AddLogGroup(newTestLogGroup("c1", 10, 1, 300, "2@l3|"))
+	store.AddLogGroup(newTestLogGroup("c1", 10, 1, 100, "2@l1|"))
+	store.AddLogGroup(newTestLogGroup("c1", 10, 1, 200, "2@l2|"))
+	store.AddLogGroup(newTestLogGroup("c1", 10, 2, 150, "2@t2|"))
+	store.AddLogGroup(newTestLogGroup("c1", 11, 1, 150, "2@p2|"))
+	store.AddLogGroup(newTestLogGroup("c2", 10, 1, 150, "2@c2|"))
+	if store.AddLogGroup(newTestLogGroup("c1", 10, 1, 150, "")) {
+		t.Errorf("AddLogGroup() accepts empty logs")
+	}
+
+	tests := []struct {
+		name string
+		node *TraceTreeNode
+		want map[uint64][]string
+	}{
+		{"window", &TraceTreeNode{ContainerId: "c1", Pid: 10, StartTime: 100, TotalTime: 100}, map[uint64][]string{1: {"l1", "l2"}, 2: {"t2"}}},
+		{"all", &TraceTreeNode{ContainerId: "c1", Pid: 10, StartTime: 0, TotalTime: 1000}, map[uint64][]string{1: {"l1", "l2", "l3"}, 2: {"t2"}}},
+		{"other pid", &TraceTreeNode{ContainerId: "c1", Pid: 11, StartTime: 0, TotalTime: 1000}, map[uint64][]string{1: {"p2"}}},
+		{"no logs", &TraceTreeNode{ContainerId: "c3", Pid: 10, StartTime: 0, TotalTime: 1000}, map[uint64][]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[uint64][]string)
+			for _, log := range store.GetTraceNodeLogs(tt.node).GetSortedLogsByThreadName() {
+				got[log.ThreadId] = log.Logs
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetTraceNodeLogs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	errorLogs := store.GetErrorNodeLogs(&ErrorTreeNode{ContainerId: "c2", Pid: 10, StartTime: 150, TotalTime: 0})
+	if got := errorLogs.GetSortedLogsByThreadName(); len(got) != 1 || !reflect.DeepEqual(got[0].Logs, []string{"c2"}) {
+		t.Errorf("GetErrorNodeLogs() = %v", got)
+	}
+}
+
+func TestLogStore_QueryInstance(t *testing.T) {
+	resolver := NewInstanceResolver()
+	store := NewLogStore(60)
+	store.SetInstanceResolver(resolver)
+	// Container restarts, logs of both containers belong to the pod.
+	store.AddLogGroup(newTestLogGroup("docker://aaaaaaaaaaaa1111", 10, 1, 100, "2@a1|"))
+	store.AddLogGroup(newTestLogGroup("docker://bbbbbbbbbbbb2222", 20, 1, 200, "2@b1|"))
+	store.AddLogGroup(newTestLogGroup("docker://cccccccccccc3333", 30, 1, 150, "2@c1|"))
+	resolver.Resolve(newTestInstanceTrace(100, "order-7d9f", "docker://aaaaaaaaaaaa1111", "order", 10))
+	resolver.Resolve(newTestInstanceTrace(200, "order-7d9f", "docker://bbbbbbbbbbbb2222", "order", 20))
+
+	node := &TraceTreeNode{InstanceKey: "pod:default/order-7d9f", ContainerId: "bbbbbbbbbbbb2222", Pid: 20, StartTime: 0, TotalTime: 1000}
+	if got := store.GetTraceNodeLogs(node).GetSortedLogsByThreadName(); len(got) != 1 || !reflect.DeepEqual(got[0].Logs, []string{"a1", "b1"}) {
+		t.Errorf("GetTraceNodeLogs() = %v, want logs of both containers", got)
+	}
+	// Unresolved instance is queried by container and pid.
+	node = &TraceTreeNode{InstanceKey: "pod:default/unknown", ContainerId: "cccccccccccc3333", Pid: 30, StartTime: 0, TotalTime: 1000}
+	if got := store.GetTraceNodeLogs(node).GetSortedLogsByThreadName(); len(got) != 1 || !reflect.DeepEqual(got[0].Logs, []string{"c1"}) {
+		t.Errorf("GetTraceNodeLogs() = %v, want logs of container", got)
+	}
+}
+
+func TestLogStore_CleanExpired(t *testing.T) {
+	store := NewLogStore(1)
+	store.now = func() uint64 { return 3_000_000_000 }
+	store.AddLogGroup(newTestLogGroup("c1", 10, 1, 1_000_000_000, "2@l1|"))
+	store.AddLogGroup(newTestLogGroup("c1", 10, 1, 2_500_000_000, "2@l2|"))
+	store.AddLogGroup(newTestLogGroup("c1", 10, 2, 1_500_000_000, "2@t2|"))
+
+	if evicted := store.CleanExpired(); evicted != 2 {
+		t.Errorf("CleanExpired() = %d, want 2", evicted)
+	}
+	if size := store.Size(); size != 1 {
+		t.Errorf("Size() = %d, want 1", size)
+	}
+}
+
+func TestLogs_AppendLogs(t *testing.T) {
+	logs := newLogs()
+	logs.addLog(1, "exec-1", "2@l1|2@l2|")
+	other := newLogs()
+	other.addLog(1, "exec-1", "2@l2|2@l3|")
+	other.addLog(2, "exec-2", "2@t2|")
+
+	got := logs.AppendLogs(other).GetSortedLogsByThreadName()
+	want := []*Log{
+		{ThreadId: 1, ThreadName: "exec-1", Logs: []string{"l1", "l2", "l3"}},
+		{ThreadId: 2, ThreadName: "exec-2", Logs: []string{"t2"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AppendLogs() = %v, want %v", got, want)
+	}
+}
@@ -1,17 +1,20 @@
 package model
 
 type CameraNodeReport struct {
-	Timestamp uint64               `json:"timestamp"`
-	TraceId   string               `json:"trace_id"`
-	Duration  uint64               `json:"duration"`
-	Data      CameraNodeReportData `json:"data"`
+	SchemaVersion int                  `json:"schema_version"`
+	Timestamp     uint64               `json:"timestamp"`
+	TraceId       string               `json:"trace_id"`
+	Duration      uint64               `json:"duration"`
+	Data          CameraNodeReportData `json:"data"`
 }
 
 type CameraNodeReportData struct {
 	EntryService        string `json:"entry_service"`
 	EntryInstance       string `json:"entry_instance,omitempty"`
+	EntryInstanceKey    string `json:"entry_instance_key,omitempty"`
 	MutatedService      string `json:"mutated_service"`
 	MutatedInstance     string `json:"mutated_instance,omitempty"`
+	MutatedInstanceKey  string `json:"mutated_instance_key,omitempty"`
 	MutatedUrl          string `json:"mutated_url,omitempty"`
 	MutatedSpan         string `json:"span_id"`
 	MutatedPod          string `json:"mutated_pod,omitempty"`
@@ -23,14 +26,52 @@ type CameraNodeReportData struct {
 
 	RelationTree    *TraceTreeNode   `json:"relation_trees"`
 	OTelClientCalls []*ApmClientCall `json:"otel_client_calls"`
+	// Nodes removed from RelationTree by pruning.
+	ElidedNodes int `json:"elided_nodes,omitempty"`
+	// On/off-CPU breakdown of the mutated node.
+	CpuBreakdown *CpuBreakdown `json:"cpu_breakdown,omitempty"`
+	SlowVerdict  SlowVerdict   `json:"slow_verdict,omitempty"`
+	// GC pauses of the mutated instance overlapped with the mutated node.
+	GcCorrelation *GcCorrelation `json:"gc_correlation,omitempty"`
+	// Lock contention of the threads which served the mutated node.
+	LockContention *LockContentionSummary `json:"lock_contention,omitempty"`
 
 	ThresholdType     ThresholdType  `json:"threshold_type"`
 	ThresholdValue    float64        `json:"threshold_value"`
 	ThresholdRange    ThresholdRange `json:"threshold_range"`
 	ThresholdMultiple float64        `json:"threshold_multiple"`
 
-	// Deprecated: Use RelationTree
+	// Deprecated: Use RelationTree, will be removed in next release.
 	Relation string `json:"relation,omitempty"`
-	// Deprecated: Use OTelClientCalls
+	// Deprecated: Use OTelClientCalls, will be removed in next release.
 	ClientCalls string `json:"client_calls,omitempty"`
 }
+
+// AttachCpuBreakdown analyzes the cpu events of the mutated node's thread and attaches the result to node and report.
+func (data *CameraNodeReportData) AttachCpuBreakdown(mutatedNode *TraceTreeNode, tid uint64, threadName string, events []*CpuEvent) *CpuBreakdown {
+	breakdown := NewCpuBreakdown(mutatedNode, tid, threadName, events)
+	mutatedNode.CpuBreakdown = breakdown
+	data.CpuBreakdown = breakdown
+	if data.SlowVerdict != VerdictGcInduced {
+		data.SlowVerdict = breakdown.Verdict
+	}
+	return breakdown
+}
+
+// AttachGcCorrelation correlates gc of the mutated instance with mutated node, the verdict is GcInduced when the overlap dominates.
+// Gc of the instance is selected by its stable key, see SelectInstanceGcs.
+func (data *CameraNodeReportData) AttachGcCorrelation(mutatedNode *TraceTreeNode, resolver *InstanceResolver, gcs []*InstanceGc, gcInducedPercent int) *GcCorrelation {
+	correlation := CorrelateGc(mutatedNode, SelectInstanceGcs(resolver, mutatedNode, gcs), gcInducedPercent)
+	data.GcCorrelation = correlation
+	if correlation.IsGcInduced {
+		data.SlowVerdict = VerdictGcInduced
+	}
+	return correlation
+}
+
+// AttachLockContention summarizes the futex events of profiles which served the mutated node, see NewLockContentionSummary.
+func (data *CameraNodeReportData) AttachLockContention(profilesList []*Profiles, topN int) *LockContentionSummary {
+	summary := NewLockContentionSummary(profilesList, topN)
+	data.LockContention = summary
+	return summary
+}
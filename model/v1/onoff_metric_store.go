@@ -0,0 +1,191 @@
+package model
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// OnOffMetricStore joins OnOffMetricGroup to Trace by traceId and spanId, whichever arrives first is cached for cacheTime seconds.
+type OnOffMetricStore struct {
+	cacheTime int64
+	now       func() int64
+
+	mutex         sync.Mutex
+	metrics       map[string]*OnOffMetrics // <traceId, metrics>
+	pendingTraces map[string]*pendingTraces
+
+	addedCount    atomic.Int64
+	attachedCount atomic.Int64
+	evictedCount  atomic.Int64
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// pendingTraces are the traces which wait for metrics.
+type pendingTraces struct {
+	traces     map[string][]*Trace // <spanId, traces>
+	expireTime int64
+}
+
+type OnOffMetricStoreStats struct {
+	MetricTraces  int   `json:"metricTraces"`
+	MetricSpans   int   `json:"metricSpans"`
+	PendingTraces int   `json:"pendingTraces"`
+	PendingSpans  int   `json:"pendingSpans"`
+	AddedCount    int64 `json:"addedCount"`
+	AttachedCount int64 `json:"attachedCount"`
+	EvictedCount  int64 `json:"evictedCount"`
+}
+
+func NewOnOffMetricStore(cacheTime int64) *OnOffMetricStore {
+	return &OnOffMetricStore{
+		cacheTime:     cacheTime,
+		now:           func() int64 { return time.Now().Unix() },
+		metrics:       make(map[string]*OnOffMetrics),
+		pendingTraces: make(map[string]*pendingTraces),
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// AddMetricGroup attaches metrics to the pending traces of span, otherwise metrics is cached until trace arrives.
+func (store *OnOffMetricStore) AddMetricGroup(group *OnOffMetricGroup) bool {
+	if group == nil || group.TraceId == "" {
+		return false
+	}
+	store.addedCount.Add(1)
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if pending, exist := store.pendingTraces[group.TraceId]; exist {
+		if traces, found := pending.traces[group.SpanId]; found {
+			for _, trace := range traces {
+				trace.SetOnOffMetrics(group.Metrics)
+			}
+			delete(pending.traces, group.SpanId)
+			if len(pending.traces) == 0 {
+				delete(store.pendingTraces, group.TraceId)
+			}
+			store.attachedCount.Add(int64(len(traces)))
+			return true
+		}
+	}
+
+	if metrics, exist := store.metrics[group.TraceId]; exist {
+		metrics.AddMetric(group.SpanId, group.Metrics)
+		metrics.ExpireTime = store.now() + store.cacheTime
+	} else {
+		metrics := NewOnOffMetrics(group, store.cacheTime)
+		metrics.ExpireTime = store.now() + store.cacheTime
+		store.metrics[group.TraceId] = metrics
+	}
+	return false
+}
+
+// AttachMetrics sets the cached metrics of span to trace, otherwise trace waits for metrics until expired.
+func (store *OnOffMetricStore) AttachMetrics(trace *Trace) bool {
+	if trace == nil || trace.Labels == nil || trace.Labels.TraceId == "" {
+		return false
+	}
+	traceId := trace.Labels.TraceId
+	spanId := trace.Labels.ApmSpanId
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	if metrics, exist := store.metrics[traceId]; exist {
+		if value := metrics.RemoveMetric(spanId); value != "" {
+			trace.SetOnOffMetrics(value)
+			if len(metrics.Metrics) == 0 {
+				delete(store.metrics, traceId)
+			}
+			store.attachedCount.Add(1)
+			return true
+		}
+	}
+
+	pending, exist := store.pendingTraces[traceId]
+	if !exist {
+		pending = &pendingTraces{traces: make(map[string][]*Trace)}
+		store.pendingTraces[traceId] = pending
+	}
+	pending.traces[spanId] = append(pending.traces[spanId], trace)
+	pending.expireTime = store.now() + store.cacheTime
+	return false
+}
+
+func (store *OnOffMetricStore) GetMetrics(traceId string, spanId string) (string, bool) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	metrics, exist := store.metrics[traceId]
+	if !exist {
+		return "", false
+	}
+	metrics.mutex.RLock()
+	defer metrics.mutex.RUnlock()
+	value, found := metrics.Metrics[spanId]
+	return value, found
+}
+
+// CleanExpired removes expired metrics and pending traces, returns the number of evicted spans.
+func (store *OnOffMetricStore) CleanExpired() int {
+	now := store.now()
+	evicted := 0
+	store.mutex.Lock()
+	for traceId, metrics := range store.metrics {
+		if metrics.ExpireTime <= now {
+			evicted += len(metrics.Metrics)
+			delete(store.metrics, traceId)
+		}
+	}
+	for traceId, pending := range store.pendingTraces {
+		if pending.expireTime <= now {
+			evicted += len(pending.traces)
+			delete(store.pendingTraces, traceId)
+		}
+	}
+	store.mutex.Unlock()
+	store.evictedCount.Add(int64(evicted))
+	return evicted
+}
+
+// Start cleans expired entries every interval until Stop.
+func (store *OnOffMetricStore) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				store.CleanExpired()
+			case <-store.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (store *OnOffMetricStore) Stop() {
+	store.stopOnce.Do(func() {
+		close(store.stopCh)
+	})
+}
+
+func (store *OnOffMetricStore) GetStats() *OnOffMetricStoreStats {
+	stats := &OnOffMetricStoreStats{
+		AddedCount:    store.addedCount.Load(),
+		AttachedCount: store.attachedCount.Load(),
+		EvictedCount:  store.evictedCount.Load(),
+	}
+	store.mutex.Lock()
+	stats.MetricTraces = len(store.metrics)
+	for _, metrics := range store.metrics {
+		stats.MetricSpans += len(metrics.Metrics)
+	}
+	stats.PendingTraces = len(store.pendingTraces)
+	for _, pending := range store.pendingTraces {
+		stats.PendingSpans += len(pending.traces)
+	}
+	store.mutex.Unlock()
+	return stats
+}
@@ -0,0 +1,51 @@
+package model
+
+import "testing"
+
+func TestOnOffMetricStore(t *testing.T) {
+	var now int64 = 100
+	store := NewOnOffMetricStore(10)
+	store.now = func() int64 { return now }
+	newTrace := func(spanId string) *Trace {
+		return &Trace{Labels: &TraceLabels{TraceId: "t1", ApmSpanId: spanId}}
+	}
+
+	// Metrics arrives first.
+	if store.AddMetricGroup(&OnOffMetricGroup{TraceId: "t1", SpanId: "s1", Metrics: "m1"}) {
+		t.Fatal("metrics should be cached")
+	}
+	trace1 := newTrace("s1")
+	if !store.AttachMetrics(trace1) || trace1.OnOffMetrics != "m1" {
+		t.Errorf("trace1 metrics = %q", trace1.OnOffMetrics)
+	}
+
+	// Trace arrives first.
+	trace2 := newTrace("s2")
+	if store.AttachMetrics(trace2) {
+		t.Fatal("trace should wait for metrics")
+	}
+	if !store.AddMetricGroup(&OnOffMetricGroup{TraceId: "t1", SpanId: "s2", Metrics: "m2"}) || trace2.OnOffMetrics != "m2" {
+		t.Errorf("trace2 metrics = %q", trace2.OnOffMetrics)
+	}
+
+	store.AddMetricGroup(&OnOffMetricGroup{TraceId: "t2", SpanId: "s3", Metrics: "m3"})
+	store.AttachMetrics(newTrace("s4"))
+	if value, found := store.GetMetrics("t2", "s3"); !found || value != "m3" {
+		t.Errorf("GetMetrics() = %q, %v", value, found)
+	}
+	if stats := store.GetStats(); stats.MetricSpans != 1 || stats.PendingSpans != 1 || stats.AttachedCount != 2 || stats.AddedCount != 3 {
+		t.Errorf("stats = %+v", stats)
+	}
+
+	now += 9
+	if evicted := store.CleanExpired(); evicted != 0 {
+		t.Errorf("CleanExpired() = %d before expired", evicted)
+	}
+	now += 1
+	if evicted := store.CleanExpired(); evicted != 2 {
+		t.Errorf("CleanExpired() = %d, want 2", evicted)
+	}
+	if stats := store.GetStats(); stats.MetricTraces != 0 || stats.PendingTraces != 0 || stats.EvictedCount != 2 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
@@ -25,6 +25,8 @@ type Profiles struct {
 	CpuEvents  []*CpuEvent
 	Futexs     []*JavaFutexEvent
 	OffsetTs   []int64
+	// Futex events overlapped with profile window, they are clipped in lock contention summary.
+	overlappedFutexs []*JavaFutexEvent
 }
 
 func NewProfiles(startTime uint64, endTime uint64) *Profiles {
@@ -37,19 +39,10 @@ func NewProfiles(startTime uint64, endTime uint64) *Profiles {
 	}
 }
 
+// AddCpuEvents adds the events overlapped with profile window, events can be unsorted and duplicated with added ones.
 func (p *Profiles) AddCpuEvents(events []*CpuEvent) {
-	var lastCpuEndTime uint64 = 0
-	if len(p.CpuEvents) > 0 {
-		lastCpuEndTime = p.CpuEvents[len(p.CpuEvents)-1].EndTime
-	}
-	for _, event := range events {
-		if event.EndTime <= lastCpuEndTime {
-			continue
-		}
-		if p.isMatch(event.StartTime, event.EndTime, 1) {
-			p.CpuEvents = append(p.CpuEvents, event)
-		}
-	}
+	allEvents := append(append(make([]*CpuEvent, 0, len(p.CpuEvents)+len(events)), p.CpuEvents...), events...)
+	p.CpuEvents = NewCpuEventIndex(allEvents).Query(p.StartTime, p.EndTime)
 }
 
 func (p *Profiles) CalcProfileEventMetrics() uint64 {
@@ -57,9 +50,11 @@ func (p *Profiles) CalcProfileEventMetrics() uint64 {
 	sumTime := uint64(0)
 	for _, event := range p.CpuEvents {
 		currentTime = event.StartTime
-		for i := 0; i < len(event.TypeSpecs); i++ {
+		for i := 0; i < len(event.TypeSpecs) && i < len(event.TimeType); i++ {
 			onoffTime, runqTime := p.getOnOffRunqTime(event, i, currentTime)
-			p.AggTime.Times[event.TimeType[i]] += onoffTime
+			if event.TimeType[i] < CPUTYPE_MAX {
+				p.AggTime.Times[event.TimeType[i]] += onoffTime
+			}
 			p.AggTime.Times[CPUType_RUNQ] += runqTime
 			sumTime = sumTime + onoffTime + runqTime
 			currentTime += event.TypeSpecs[i]
@@ -68,42 +63,44 @@ func (p *Profiles) CalcProfileEventMetrics() uint64 {
 	return sumTime
 }
 
+// getOnOffRunqTime clips the i-th segment [currentTime, currentTime+TypeSpecs[i]) to profile window,
+// run queue latency is at the end of off-cpu segment.
 func (p *Profiles) getOnOffRunqTime(event *CpuEvent, i int, currentTime uint64) (onoffTime uint64, runqTime uint64) {
-	onoffTime = event.TypeSpecs[i]
-	if p.StartTime-onoffTime > currentTime || p.EndTime < currentTime {
-		return 0, 0
-	}
-
-	if currentTime < p.StartTime {
-		onoffTime = onoffTime + currentTime - p.StartTime
-	} else if currentTime+onoffTime > p.EndTime {
-		onoffTime = p.EndTime - currentTime
+	endTime := currentTime + event.TypeSpecs[i]
+	if event.TimeType[i] == CPUType_ON || i/2 >= len(event.RunqLatency) {
+		return p.clip(currentTime, endTime), 0
 	}
 
-	if event.TimeType[i] > 0 {
-		runqTime = event.RunqLatency[i/2] * 1000 // us -> ns
-		if runqTime > 0 {
-			if onoffTime < runqTime {
-				runqTime = onoffTime
-				onoffTime = 0
-			} else {
-				// OffData Minus Runq
-				onoffTime -= runqTime
-			}
-		}
+	runqStartTime := currentTime
+	if runqLatency := event.RunqLatency[i/2] * 1000; runqLatency < event.TypeSpecs[i] { // us -> ns
+		runqStartTime = endTime - runqLatency
 	}
-	return onoffTime, runqTime
+	return p.clip(currentTime, runqStartTime), p.clip(runqStartTime, endTime)
 }
 
-func (p *Profiles) isMatch(start uint64, end uint64, correctMilli uint64) bool {
-	return p.StartTime/1000000 <= (end/1000000)+correctMilli || (p.EndTime/1000000)+correctMilli >= start
+// clip returns the overlapped time of [startTime, endTime) and profile window.
+func (p *Profiles) clip(startTime uint64, endTime uint64) uint64 {
+	if startTime < p.StartTime {
+		startTime = p.StartTime
+	}
+	if endTime > p.EndTime {
+		endTime = p.EndTime
+	}
+	if endTime <= startTime {
+		return 0
+	}
+	return endTime - startTime
 }
 
+// AddFutexEvents adds the events inside profile window to Futexs.
 func (p *Profiles) AddFutexEvents(events []*JavaFutexEvent) {
 	for _, event := range events {
 		if event.StartTime >= p.StartTime && event.EndTime <= p.EndTime {
 			p.Futexs = append(p.Futexs, event)
 		}
+		if event.StartTime < p.EndTime && event.EndTime > p.StartTime {
+			p.overlappedFutexs = append(p.overlappedFutexs, event)
+		}
 	}
 }
 
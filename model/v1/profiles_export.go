@@ -0,0 +1,146 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	OnCpuSampleType  = "on_cpu"
+	OffCpuSampleType = "off_cpu"
+)
+
+// StackParser returns the frames from root to leaf for the i-th segment of event.
+type StackParser func(event *CpuEvent, i int) []string
+
+type StackSample struct {
+	Frames     []string
+	OnCpuTime  uint64
+	OffCpuTime uint64
+}
+
+// DefaultStackParser uses Stack or OnInfo for on-cpu segments and OffInfo for off-cpu segments,
+// the cpu type of off-cpu segment is added as leaf frame, eg. [net].
+func DefaultStackParser(event *CpuEvent, i int) []string {
+	cpuType := event.TimeType[i]
+	if cpuType == CPUType_ON {
+		if frames := ParseStackFrames(event.Stack); len(frames) > 0 {
+			return frames
+		}
+		return ParseStackFrames(event.OnInfo)
+	}
+	frames := ParseStackFrames(event.OffInfo)
+	if int(cpuType) < len(CPUTypes) {
+		frames = append(frames, fmt.Sprintf("[%s]", CPUTypes[cpuType]))
+	}
+	return frames
+}
+
+// ParseStackFrames parses folded stack "root;...;leaf" or stack trace with one frame per line from leaf to root.
+func ParseStackFrames(stack string) []string {
+	stack = strings.TrimSpace(stack)
+	if stack == "" {
+		return nil
+	}
+	if !strings.Contains(stack, "\n") && strings.Contains(stack, ";") {
+		return splitFrames(strings.Split(stack, ";"))
+	}
+	frames := splitFrames(strings.Split(stack, "\n"))
+	for i, j := 0, len(frames)-1; i < j; i, j = i+1, j-1 {
+		frames[i], frames[j] = frames[j], frames[i]
+	}
+	return frames
+}
+
+func splitFrames(values []string) []string {
+	frames := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "at ")
+		if value != "" {
+			frames = append(frames, value)
+		}
+	}
+	return frames
+}
+
+// BuildStackSamples aggregates the time of cpu events in each profiles window by stack, thread name is the root frame.
+// Time is weighted by TypeSpecs, the run queue latency of off-cpu segment is counted as off-cpu time with leaf [runq].
+func BuildStackSamples(profilesList []*Profiles, parser StackParser) []*StackSample {
+	if parser == nil {
+		parser = DefaultStackParser
+	}
+	samples := make([]*StackSample, 0)
+	sampleMap := make(map[string]*StackSample)
+	addSample := func(frames []string, onCpuTime uint64, offCpuTime uint64) {
+		key := strings.Join(frames, ";")
+		sample, exist := sampleMap[key]
+		if !exist {
+			sample = &StackSample{Frames: frames}
+			sampleMap[key] = sample
+			samples = append(samples, sample)
+		}
+		sample.OnCpuTime += onCpuTime
+		sample.OffCpuTime += offCpuTime
+	}
+
+	for _, profiles := range profilesList {
+		threadFrame := fmt.Sprintf("%s-%d", profiles.ThreadName, profiles.Tid)
+		for _, event := range profiles.CpuEvents {
+			currentTime := event.StartTime
+			for i := 0; i < len(event.TypeSpecs) && i < len(event.TimeType); i++ {
+				onoffTime, runqTime := profiles.getOnOffRunqTime(event, i, currentTime)
+				currentTime += event.TypeSpecs[i]
+				if onoffTime == 0 && runqTime == 0 {
+					continue
+				}
+				frames := append([]string{threadFrame}, parser(event, i)...)
+				if event.TimeType[i] == CPUType_ON {
+					addSample(frames, onoffTime, 0)
+				} else {
+					addSample(frames, 0, onoffTime)
+				}
+				if runqTime > 0 {
+					runqFrames := append([]string{}, frames...)
+					if last := runqFrames[len(runqFrames)-1]; strings.HasPrefix(last, "[") && strings.HasSuffix(last, "]") {
+						runqFrames = runqFrames[:len(runqFrames)-1]
+					}
+					runqFrames = append(runqFrames, fmt.Sprintf("[%s]", CPUTypes[CPUType_RUNQ]))
+					addSample(runqFrames, 0, runqTime)
+				}
+			}
+		}
+	}
+	return samples
+}
+
+// WriteFoldedStacks writes "frame;frame value" lines for flamegraph, value is the time in ns of sampleType.
+func WriteFoldedStacks(w io.Writer, samples []*StackSample, sampleType string) error {
+	lines := make([]string, 0, len(samples))
+	for _, sample := range samples {
+		value := sample.OnCpuTime
+		if sampleType == OffCpuSampleType {
+			value = sample.OffCpuTime
+		}
+		if value == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s %d", foldedStack(sample.Frames), value))
+	}
+	sort.Strings(lines)
+	for _, line := range lines {
+		if _, err := io.WriteString(w, line+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func foldedStack(frames []string) string {
+	escaped := make([]string, len(frames))
+	for i, frame := range frames {
+		escaped[i] = strings.NewReplacer(";", ":", " ", "_").Replace(frame)
+	}
+	return strings.Join(escaped, ";")
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestExportProfiles(t *testing.T) {
+	profiles := NewProfiles(1000, 2000)
+	profiles.Tid = 12
+	profiles.ThreadName = "http-nio"
+	profiles.CpuEvents = []*CpuEvent{{
+		StartTime:   1000,
+		EndTime:     2000,
+		TypeSpecs:   []uint64{400, 600},
+		TimeType:    []CPUType{CPUType_ON, CPUType_NET},
+		RunqLatency: []uint64{0},
+		Stack:       "main;handle;encode",
+		OffInfo:     "at socketRead\nat handle\nat main",
+	}}
+
+	samples := BuildStackSamples([]*Profiles{profiles}, nil)
+	var folded bytes.Buffer
+	if err := WriteFoldedStacks(&folded, samples, OffCpuSampleType); err != nil {
+		t.Fatal(err)
+	}
+	if want := "http-nio-12;main;handle;socketRead;[net] 600\n"; folded.String() != want {
+		t.Errorf("off-cpu folded = %q, want %q", folded.String(), want)
+	}
+
+}
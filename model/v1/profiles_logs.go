@@ -1,6 +1,7 @@
 package model
 
 import (
+	"fmt"
 	"sort"
 	"strconv"
 	"strings"
@@ -39,6 +40,34 @@ func (logs *Logs) MergeLog(other *Logs) *Logs {
 	return logs
 }
 
+// AppendLogs appends the logs of other threads, logs already exist in the thread are skipped.
+func (logs *Logs) AppendLogs(other *Logs) *Logs {
+	for tid, otherLog := range other.logMap {
+		log, exist := logs.logMap[tid]
+		if !exist {
+			logs.logMap[tid] = &Log{
+				ThreadId:   tid,
+				ThreadName: otherLog.ThreadName,
+				Logs:       append(make([]string, 0, len(otherLog.Logs)), otherLog.Logs...),
+			}
+			continue
+		}
+		existLogs := make(map[string]bool, len(log.Logs))
+		for _, value := range log.Logs {
+			existLogs[value] = true
+		}
+		for _, value := range otherLog.Logs {
+			if !existLogs[value] {
+				log.Logs = append(log.Logs, value)
+			}
+		}
+		if log.ThreadName == "" {
+			log.ThreadName = otherLog.ThreadName
+		}
+	}
+	return logs
+}
+
 func (logs *Logs) GetSortedLogsByThreadName() []*Log {
 	sortedLogs := make([]*Log, 0, len(logs.logMap))
 	for _, v := range logs.logMap {
@@ -48,32 +77,40 @@ func (logs *Logs) GetSortedLogsByThreadName() []*Log {
 	return sortedLogs
 }
 
+// SplitLogs decodes "len@content|" frames, frames after a broken one are dropped, see DecodeLogFrames.
 func SplitLogs(logText string) []string {
+	logs, _ := DecodeLogFrames(logText)
+	return logs
+}
+
+// DecodeLogFrames decodes "len@content|" frames, len is the byte length of content written by agent.
+// Logs of frames before the broken one are returned with error.
+func DecodeLogFrames(logText string) ([]string, error) {
 	logs := make([]string, 0)
-	size := len([]rune(logText))
-	var tempLog = logText
-	for startIndex := 0; startIndex < size; {
-		tempLog = tempLog[startIndex:]
-		lenIndex := strings.Index(tempLog, "@")
+	offset := 0
+	for offset < len(logText) {
+		frame := logText[offset:]
+		lenIndex := strings.Index(frame, "@")
 		if lenIndex == -1 {
-			return logs
+			return logs, fmt.Errorf("log frame at %d has no length", offset)
 		}
-		logSize, err := strconv.Atoi(tempLog[:lenIndex])
-		if err != nil {
-			return logs
+		logSize, err := strconv.Atoi(frame[:lenIndex])
+		if err != nil || logSize < 0 {
+			return logs, fmt.Errorf("log frame at %d has invalid length %q", offset, frame[:lenIndex])
 		}
 
-		endIndex := lenIndex + 1 + logSize
-		if endIndex > size || tempLog[endIndex] != '|' {
-			return logs
+		content := frame[lenIndex+1:]
+		if logSize >= len(content) || content[logSize] != '|' {
+			return logs, fmt.Errorf("log frame at %d is not ended with '|' after %d bytes", offset, logSize)
 		}
 		if logSize > 0 {
-			singleLog := tempLog[lenIndex+1 : endIndex]
+			// Agent may cut the log in the middle of a multi-byte character.
+			singleLog := strings.ToValidUTF8(content[:logSize], "")
 			logs = append(logs, strings.Split(singleLog, "<br>")...)
 		}
-		startIndex = endIndex + 1
+		offset += lenIndex + 1 + logSize + 1
 	}
-	return logs
+	return logs, nil
 }
 
 type Log struct {
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NewCameraNodeReport builds the slow report of entryTrace from the relation tree and client calls returned by QueryMutatedSlowTraceTree.
+func NewCameraNodeReport(entryTrace *Trace, relationTree *TraceTreeNode, clientCalls []*ApmClientCall) (*CameraNodeReport, error) {
+	if entryTrace == nil || entryTrace.Labels == nil {
+		return nil, fmt.Errorf("entry trace is missing")
+	}
+	entryLabels := entryTrace.Labels
+	if relationTree == nil {
+		return nil, fmt.Errorf("relation tree is missing for trace(%s)", entryLabels.TraceId)
+	}
+	mutatedNode := relationTree.GetMutatedNode()
+	if mutatedNode == nil {
+		return nil, fmt.Errorf("mutated node is not found for trace(%s)", entryLabels.TraceId)
+	}
+	if clientCalls == nil {
+		clientCalls = make([]*ApmClientCall, 0)
+	}
+	// Deprecated Relation and ClientCalls are filled until consumers move to RelationTree and OTelClientCalls.
+	relation, err := json.Marshal(relationTree)
+	if err != nil {
+		return nil, fmt.Errorf("marshal relation tree of trace(%s) failed: %w", entryLabels.TraceId, err)
+	}
+	clientCallsJson, err := json.Marshal(clientCalls)
+	if err != nil {
+		return nil, fmt.Errorf("marshal client calls of trace(%s) failed: %w", entryLabels.TraceId, err)
+	}
+
+	return &CameraNodeReport{
+		SchemaVersion: ReportSchemaVersion,
+		Timestamp:     entryTrace.Timestamp,
+		TraceId:       entryLabels.TraceId,
+		Duration:      entryLabels.Duration,
+		Data: CameraNodeReportData{
+			EntryService:        entryLabels.ServiceName,
+			EntryInstance:       entryTrace.GetInstanceId(),
+			EntryInstanceKey:    entryTrace.GetInstanceKey(),
+			MutatedService:      mutatedNode.ServiceName,
+			MutatedInstance:     mutatedNode.Id,
+			MutatedInstanceKey:  mutatedNode.InstanceKey,
+			MutatedUrl:          mutatedNode.Url,
+			MutatedSpan:         mutatedNode.SpanId,
+			MutatedPod:          mutatedNode.Pod,
+			MutatedPodNS:        mutatedNode.PodNS,
+			MutatedWorkloadName: mutatedNode.Workload,
+			MutatedWorkloadType: mutatedNode.WorkloadType,
+			Cause:               entryTrace.MutatedType,
+			ContentKey:          entryLabels.Url,
+			RelationTree:        relationTree,
+			OTelClientCalls:     clientCalls,
+			ThresholdType:       entryLabels.ThresholdType,
+			ThresholdValue:      entryLabels.ThresholdValue,
+			ThresholdRange:      entryLabels.ThresholdRange,
+			ThresholdMultiple:   entryLabels.ThresholdMultiple,
+			Relation:            string(relation),
+			ClientCalls:         string(clientCallsJson),
+		},
+	}, nil
+}
+
+// NewErrorReport builds the error report of entryTrace from the relation tree returned by QueryErrorTraceTree,
+// the cause is the earliest exception of the root cause error node.
+func NewErrorReport(name string, entryTrace *Trace, relationTree *ErrorTreeNode) (*ErrorReport, error) {
+	if entryTrace == nil || entryTrace.Labels == nil {
+		return nil, fmt.Errorf("entry trace is missing")
+	}
+	entryLabels := entryTrace.Labels
+	if relationTree == nil {
+		return nil, fmt.Errorf("relation tree is missing for trace(%s)", entryLabels.TraceId)
+	}
+	mutatedNode := relationTree.GetMutatedNode()
+	if mutatedNode == nil {
+		return nil, fmt.Errorf("root cause error node is not found for trace(%s)", entryLabels.TraceId)
+	}
+
+	data := &ErrorReportData{
+		EntryService:        entryLabels.ServiceName,
+		EntryInstance:       entryTrace.GetInstanceId(),
+		EntryInstanceKey:    entryTrace.GetInstanceKey(),
+		MutatedService:      mutatedNode.ServiceName,
+		MutatedInstance:     mutatedNode.Id,
+		MutatedInstanceKey:  mutatedNode.InstanceKey,
+		MutatedUrl:          mutatedNode.Url,
+		MutatedSpan:         mutatedNode.SpanId,
+		MutatedPod:          mutatedNode.Pod,
+		MutatedPodNS:        mutatedNode.PodNS,
+		MutatedWorkloadName: mutatedNode.Workload,
+		MutatedWorkloadType: mutatedNode.WorkloadType,
+		ContentKey:          entryLabels.Url,
+		RelationTree:        relationTree,
+		ThresholdType:       entryLabels.ThresholdType,
+		ThresholdValue:      entryLabels.ThresholdValue,
+		ThresholdRange:      entryLabels.ThresholdRange,
+		ThresholdMultiple:   entryLabels.ThresholdMultiple,
+	}
+	if exception := mutatedNode.GetRootCauseError(); exception != nil {
+		data.Cause = exception.Type
+		data.CauseMessage = exception.Message
+	}
+	return &ErrorReport{
+		SchemaVersion: ReportSchemaVersion,
+		Name:          name,
+		Timestamp:     entryTrace.Timestamp,
+		TraceId:       entryLabels.TraceId,
+		Duration:      entryLabels.Duration,
+		Data:          data,
+	}, nil
+}
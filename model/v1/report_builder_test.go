@@ -0,0 +1,94 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func newTestEntryTrace() *Trace {
+	return &Trace{
+		Timestamp:   1000,
+		PodName:     "entry-pod",
+		MutatedType: "cpu",
+		Labels: &TraceLabels{
+			ServiceName:       "entry",
+			Url:               "GET /order",
+			TraceId:           "trace-1",
+			Duration:          500,
+			ThresholdType:     P90ThresholdType,
+			ThresholdRange:    RangeLast1h,
+			ThresholdValue:    200,
+			ThresholdMultiple: 1,
+		},
+	}
+}
+
+func TestNewCameraNodeReport(t *testing.T) {
+	root := &TraceTreeNode{Id: "entry-pod", ServiceName: "entry", SpanId: "s1", IsPath: true}
+	mutated := &TraceTreeNode{Id: "db-pod", ServiceName: "order", Url: "/query", SpanId: "s2", Pod: "db-pod", PodNS: "default", Workload: "order", WorkloadType: "Deployment", IsPath: true, IsMutated: true}
+	root.AddChild(&TraceTreeNode{ServiceName: "other", SpanId: "s3"})
+	root.AddChild(mutated)
+
+	clientCalls := []*ApmClientCall{{ClientName: "db", ClientSpanId: "c1"}}
+	report, err := NewCameraNodeReport(newTestEntryTrace(), root, clientCalls)
+	if err != nil {
+		t.Fatalf("NewCameraNodeReport() error = %v", err)
+	}
+	data := report.Data
+	if report.TraceId != "trace-1" || report.Duration != 500 || report.Timestamp != 1000 {
+		t.Errorf("NewCameraNodeReport() = %+v", report)
+	}
+	if data.EntryService != "entry" || data.EntryInstance != "entry-pod" || data.ContentKey != "GET /order" || data.Cause != "cpu" {
+		t.Errorf("entry fields = %+v", data)
+	}
+	if data.MutatedService != "order" || data.MutatedInstance != "db-pod" || data.MutatedUrl != "/query" || data.MutatedSpan != "s2" ||
+		data.MutatedPod != "db-pod" || data.MutatedPodNS != "default" || data.MutatedWorkloadName != "order" || data.MutatedWorkloadType != "Deployment" {
+		t.Errorf("mutated fields = %+v", data)
+	}
+	if data.ThresholdType != P90ThresholdType || data.ThresholdRange != RangeLast1h || data.ThresholdValue != 200 || data.ThresholdMultiple != 1 {
+		t.Errorf("threshold fields = %+v", data)
+	}
+	if data.RelationTree != root || len(data.OTelClientCalls) != 1 {
+		t.Errorf("relation fields = %+v", data)
+	}
+	// Deprecated fields are still filled for old consumers.
+	relationTree := &TraceTreeNode{}
+	if err := json.Unmarshal([]byte(data.Relation), relationTree); err != nil || relationTree.GetMutatedNode() == nil || relationTree.GetMutatedNode().SpanId != "s2" {
+		t.Errorf("Relation = %s, err = %v", data.Relation, err)
+	}
+	var oldClientCalls []*ApmClientCall
+	if err := json.Unmarshal([]byte(data.ClientCalls), &oldClientCalls); err != nil || len(oldClientCalls) != 1 || oldClientCalls[0].ClientSpanId != "c1" {
+		t.Errorf("ClientCalls = %s, err = %v", data.ClientCalls, err)
+	}
+
+	if _, err := NewCameraNodeReport(newTestEntryTrace(), &TraceTreeNode{}, nil); err == nil {
+		t.Errorf("NewCameraNodeReport() without mutated node expects error")
+	}
+}
+
+func TestNewErrorReport(t *testing.T) {
+	root := &ErrorTreeNode{Id: "entry-pod", ServiceName: "entry", SpanId: "s1", IsPath: true, IsError: true}
+	mutated := &ErrorTreeNode{Id: "db-pod", ServiceName: "order", SpanId: "s2", IsPath: true, IsError: true, IsMutated: true,
+		ErrorSpans: []*ErrorSpan{{Exceptions: []*Exception{
+			NewOtelException(20, "java.io.IOException", "broken pipe", ""),
+			NewOtelException(10, "java.sql.SQLException", "deadlock", ""),
+		}}},
+	}
+	root.AddChild(mutated)
+
+	report, err := NewErrorReport("error", newTestEntryTrace(), root)
+	if err != nil {
+		t.Fatalf("NewErrorReport() error = %v", err)
+	}
+	data := report.Data
+	if report.Name != "error" || report.TraceId != "trace-1" || data.EntryService != "entry" || data.MutatedService != "order" || data.MutatedSpan != "s2" {
+		t.Errorf("NewErrorReport() = %+v, data = %+v", report, data)
+	}
+	if data.Cause != "java.sql.SQLException" || data.CauseMessage != "deadlock" {
+		t.Errorf("cause = %s(%s), want java.sql.SQLException(deadlock)", data.Cause, data.CauseMessage)
+	}
+
+	if _, err := NewErrorReport("error", &Trace{}, root); err == nil {
+		t.Errorf("NewErrorReport() without labels expects error")
+	}
+}
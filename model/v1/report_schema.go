@@ -0,0 +1,97 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ReportSchemaVersion is the version of CameraNodeReport and ErrorReport documents, see reportpb/report.proto.
+//
+//	1: no schema_version, CameraNodeReport carries relation tree and client calls as json string in relation and client_calls.
+//	2: relation_trees and otel_client_calls, deprecated relation and client_calls are kept until next release.
+const ReportSchemaVersion = 2
+
+type reportVersion struct {
+	SchemaVersion int `json:"schema_version"`
+}
+
+func getReportSchemaVersion(data []byte) (int, error) {
+	version := &reportVersion{}
+	if err := json.Unmarshal(data, version); err != nil {
+		return 0, err
+	}
+	if version.SchemaVersion == 0 {
+		return 1, nil
+	}
+	if version.SchemaVersion > ReportSchemaVersion {
+		return 0, fmt.Errorf("unsupported report schema version %d, latest is %d", version.SchemaVersion, ReportSchemaVersion)
+	}
+	return version.SchemaVersion, nil
+}
+
+// UpgradeCameraNodeReport reads the CameraNodeReport document of any schema version and upgrades it to ReportSchemaVersion.
+func UpgradeCameraNodeReport(data []byte) (*CameraNodeReport, error) {
+	version, err := getReportSchemaVersion(data)
+	if err != nil {
+		return nil, err
+	}
+	report := &CameraNodeReport{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, err
+	}
+	if version < 2 {
+		if report.Data.RelationTree == nil && report.Data.Relation != "" {
+			relationTree := &TraceTreeNode{}
+			if err := json.Unmarshal([]byte(report.Data.Relation), relationTree); err != nil {
+				return nil, fmt.Errorf("invalid relation of trace(%s): %w", report.TraceId, err)
+			}
+			report.Data.RelationTree = relationTree
+		}
+		if report.Data.OTelClientCalls == nil && report.Data.ClientCalls != "" {
+			clientCalls := make([]*ApmClientCall, 0)
+			if err := json.Unmarshal([]byte(report.Data.ClientCalls), &clientCalls); err != nil {
+				return nil, fmt.Errorf("invalid client_calls of trace(%s): %w", report.TraceId, err)
+			}
+			report.Data.OTelClientCalls = clientCalls
+		}
+	}
+	if report.Data.OTelClientCalls == nil {
+		report.Data.OTelClientCalls = make([]*ApmClientCall, 0)
+	}
+	if report.Data.RelationTree != nil {
+		report.Data.RelationTree.linkParent()
+	}
+	report.SchemaVersion = ReportSchemaVersion
+	return report, nil
+}
+
+// UpgradeErrorReport reads the ErrorReport document of any schema version and upgrades it to ReportSchemaVersion.
+func UpgradeErrorReport(data []byte) (*ErrorReport, error) {
+	if _, err := getReportSchemaVersion(data); err != nil {
+		return nil, err
+	}
+	report := &ErrorReport{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, err
+	}
+	if report.Data != nil && report.Data.RelationTree != nil {
+		report.Data.RelationTree.linkParent()
+	}
+	report.SchemaVersion = ReportSchemaVersion
+	return report, nil
+}
+
+// linkParent restores Parent which is not kept in json.
+func (node *TraceTreeNode) linkParent() {
+	for _, child := range node.Children {
+		child.Parent = node
+		child.linkParent()
+	}
+}
+
+func (node *ErrorTreeNode) linkParent() {
+	for _, child := range node.Children {
+		child.Parent = node
+		child.linkParent()
+	}
+}
@@ -0,0 +1,70 @@
+package model
+
+import "testing"
+
+func TestUpgradeCameraNodeReport(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantErr     bool
+		wantService string
+		wantCalls   int
+	}{
+		{
+			name:        "version 1 with relation string",
+			data:        `{"timestamp":1,"trace_id":"t1","data":{"mutated_service":"order","relation":"{\"serviceName\":\"entry\",\"children\":[{\"serviceName\":\"order\",\"isMutated\":true,\"isPath\":true}]}","client_calls":"[{\"client_name\":\"SELECT\"}]"}}`,
+			wantService: "order",
+			wantCalls:   1,
+		},
+		{
+			name:        "version 2",
+			data:        `{"schema_version":2,"trace_id":"t1","data":{"relation_trees":{"serviceName":"entry","children":[{"serviceName":"order","isMutated":true,"isPath":true}]},"otel_client_calls":null}}`,
+			wantService: "order",
+		},
+		{
+			name:    "invalid relation",
+			data:    `{"trace_id":"t1","data":{"relation":"{"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "newer version",
+			data:    `{"schema_version":3,"trace_id":"t1","data":{}}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := UpgradeCameraNodeReport([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpgradeCameraNodeReport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if report.SchemaVersion != ReportSchemaVersion {
+				t.Errorf("SchemaVersion = %d, want %d", report.SchemaVersion, ReportSchemaVersion)
+			}
+			mutatedNode := report.Data.RelationTree.GetMutatedNode()
+			if mutatedNode == nil || mutatedNode.ServiceName != tt.wantService || mutatedNode.Parent != report.Data.RelationTree {
+				t.Errorf("mutated node = %+v, want %s linked to root", mutatedNode, tt.wantService)
+			}
+			if len(report.Data.OTelClientCalls) != tt.wantCalls {
+				t.Errorf("OTelClientCalls = %d, want %d", len(report.Data.OTelClientCalls), tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestUpgradeErrorReport(t *testing.T) {
+	report, err := UpgradeErrorReport([]byte(`{"name":"error","trace_id":"t1","data":{"relation_trees":{"serviceName":"entry","children":[{"serviceName":"order"}]}}}`))
+	if err != nil {
+		t.Fatalf("UpgradeErrorReport() error = %v", err)
+	}
+	root := report.Data.RelationTree
+	if report.SchemaVersion != ReportSchemaVersion || root.Children[0].Parent != root {
+		t.Errorf("UpgradeErrorReport() = %+v", report)
+	}
+	if _, err := UpgradeErrorReport([]byte(`{"schema_version":99}`)); err == nil {
+		t.Errorf("UpgradeErrorReport() with newer version expects error")
+	}
+}
@@ -0,0 +1,676 @@
+package reportpb
+
+import "github.com/CloudDetail/apo-module/model/v1"
+
+func FromCameraNodeReport(report *model.CameraNodeReport) *CameraNodeReport {
+	if report == nil {
+		return nil
+	}
+	data := &report.Data
+	return &CameraNodeReport{
+		SchemaVersion: int32(model.ReportSchemaVersion),
+		Timestamp:     report.Timestamp,
+		TraceId:       report.TraceId,
+		Duration:      report.Duration,
+		Data: &CameraNodeReportData{
+			EntryService:        data.EntryService,
+			EntryInstance:       data.EntryInstance,
+			EntryInstanceKey:    data.EntryInstanceKey,
+			MutatedService:      data.MutatedService,
+			MutatedInstance:     data.MutatedInstance,
+			MutatedInstanceKey:  data.MutatedInstanceKey,
+			MutatedUrl:          data.MutatedUrl,
+			SpanId:              data.MutatedSpan,
+			MutatedPod:          data.MutatedPod,
+			MutatedPodNs:        data.MutatedPodNS,
+			MutatedWorkloadName: data.MutatedWorkloadName,
+			MutatedWorkloadType: data.MutatedWorkloadType,
+			Cause:               data.Cause,
+			ContentKey:          data.ContentKey,
+			RelationTrees:       FromTraceTreeNode(data.RelationTree),
+			OtelClientCalls:     fromApmClientCalls(data.OTelClientCalls),
+			ElidedNodes:         int32(data.ElidedNodes),
+			CpuBreakdown:        fromCpuBreakdown(data.CpuBreakdown),
+			SlowVerdict:         string(data.SlowVerdict),
+			GcCorrelation:       fromGcCorrelation(data.GcCorrelation),
+			LockContention:      fromLockContentionSummary(data.LockContention),
+			ThresholdType:       string(data.ThresholdType),
+			ThresholdValue:      data.ThresholdValue,
+			ThresholdRange:      string(data.ThresholdRange),
+			ThresholdMultiple:   data.ThresholdMultiple,
+			Relation:            data.Relation,
+			ClientCalls:         data.ClientCalls,
+		},
+	}
+}
+
+func ToCameraNodeReport(report *CameraNodeReport) *model.CameraNodeReport {
+	if report == nil {
+		return nil
+	}
+	result := &model.CameraNodeReport{
+		SchemaVersion: model.ReportSchemaVersion,
+		Timestamp:     report.Timestamp,
+		TraceId:       report.TraceId,
+		Duration:      report.Duration,
+		Data: model.CameraNodeReportData{
+			OTelClientCalls: make([]*model.ApmClientCall, 0),
+		},
+	}
+	if data := report.Data; data != nil {
+		result.Data = model.CameraNodeReportData{
+			EntryService:        data.EntryService,
+			EntryInstance:       data.EntryInstance,
+			EntryInstanceKey:    data.EntryInstanceKey,
+			MutatedService:      data.MutatedService,
+			MutatedInstance:     data.MutatedInstance,
+			MutatedInstanceKey:  data.MutatedInstanceKey,
+			MutatedUrl:          data.MutatedUrl,
+			MutatedSpan:         data.SpanId,
+			MutatedPod:          data.MutatedPod,
+			MutatedPodNS:        data.MutatedPodNs,
+			MutatedWorkloadName: data.MutatedWorkloadName,
+			MutatedWorkloadType: data.MutatedWorkloadType,
+			Cause:               data.Cause,
+			ContentKey:          data.ContentKey,
+			RelationTree:        ToTraceTreeNode(data.RelationTrees),
+			OTelClientCalls:     toApmClientCalls(data.OtelClientCalls),
+			ElidedNodes:         int(data.ElidedNodes),
+			CpuBreakdown:        toCpuBreakdown(data.CpuBreakdown),
+			SlowVerdict:         model.SlowVerdict(data.SlowVerdict),
+			GcCorrelation:       toGcCorrelation(data.GcCorrelation),
+			LockContention:      toLockContentionSummary(data.LockContention),
+			ThresholdType:       model.ThresholdType(data.ThresholdType),
+			ThresholdValue:      data.ThresholdValue,
+			ThresholdRange:      model.ThresholdRange(data.ThresholdRange),
+			ThresholdMultiple:   data.ThresholdMultiple,
+			Relation:            data.Relation,
+			ClientCalls:         data.ClientCalls,
+		}
+	}
+	return result
+}
+
+func FromErrorReport(report *model.ErrorReport) *ErrorReport {
+	if report == nil {
+		return nil
+	}
+	result := &ErrorReport{
+		SchemaVersion: int32(model.ReportSchemaVersion),
+		Name:          report.Name,
+		Timestamp:     report.Timestamp,
+		TraceId:       report.TraceId,
+		IsDrop:        report.IsDrop,
+		Duration:      report.Duration,
+	}
+	if data := report.Data; data != nil {
+		result.Data = &ErrorReportData{
+			EntryService:        data.EntryService,
+			EntryInstance:       data.EntryInstance,
+			EntryInstanceKey:    data.EntryInstanceKey,
+			MutatedService:      data.MutatedService,
+			MutatedInstance:     data.MutatedInstance,
+			MutatedInstanceKey:  data.MutatedInstanceKey,
+			MutatedUrl:          data.MutatedUrl,
+			SpanId:              data.MutatedSpan,
+			MutatedPod:          data.MutatedPod,
+			MutatedPodNs:        data.MutatedPodNS,
+			MutatedWorkloadName: data.MutatedWorkloadName,
+			MutatedWorkloadType: data.MutatedWorkloadType,
+			ContentKey:          data.ContentKey,
+			Cause:               data.Cause,
+			CauseMessage:        data.CauseMessage,
+			RelationTrees:       FromErrorTreeNode(data.RelationTree),
+			ElidedNodes:         int32(data.ElidedNodes),
+			ThresholdType:       string(data.ThresholdType),
+			ThresholdValue:      data.ThresholdValue,
+			ThresholdRange:      string(data.ThresholdRange),
+			ThresholdMultiple:   data.ThresholdMultiple,
+		}
+	}
+	return result
+}
+
+func ToErrorReport(report *ErrorReport) *model.ErrorReport {
+	if report == nil {
+		return nil
+	}
+	result := &model.ErrorReport{
+		SchemaVersion: model.ReportSchemaVersion,
+		Name:          report.Name,
+		Timestamp:     report.Timestamp,
+		TraceId:       report.TraceId,
+		IsDrop:        report.IsDrop,
+		Duration:      report.Duration,
+	}
+	if data := report.Data; data != nil {
+		result.Data = &model.ErrorReportData{
+			EntryService:        data.EntryService,
+			EntryInstance:       data.EntryInstance,
+			EntryInstanceKey:    data.EntryInstanceKey,
+			MutatedService:      data.MutatedService,
+			MutatedInstance:     data.MutatedInstance,
+			MutatedInstanceKey:  data.MutatedInstanceKey,
+			MutatedUrl:          data.MutatedUrl,
+			MutatedSpan:         data.SpanId,
+			MutatedPod:          data.MutatedPod,
+			MutatedPodNS:        data.MutatedPodNs,
+			MutatedWorkloadName: data.MutatedWorkloadName,
+			MutatedWorkloadType: data.MutatedWorkloadType,
+			ContentKey:          data.ContentKey,
+			Cause:               data.Cause,
+			CauseMessage:        data.CauseMessage,
+			RelationTree:        ToErrorTreeNode(data.RelationTrees),
+			ElidedNodes:         int(data.ElidedNodes),
+			ThresholdType:       model.ThresholdType(data.ThresholdType),
+			ThresholdValue:      data.ThresholdValue,
+			ThresholdRange:      model.ThresholdRange(data.ThresholdRange),
+			ThresholdMultiple:   data.ThresholdMultiple,
+		}
+	}
+	return result
+}
+
+func FromTraceTreeNode(node *model.TraceTreeNode) *TraceTreeNode {
+	if node == nil {
+		return nil
+	}
+	result := &TraceTreeNode{
+		Id:                node.Id,
+		InstanceKey:       node.InstanceKey,
+		ServiceName:       node.ServiceName,
+		Url:               node.Url,
+		StartTime:         node.StartTime,
+		TotalTime:         node.TotalTime,
+		ClientTime:        node.ClientTime,
+		P90:               node.P90,
+		P90Source:         string(node.P90Source),
+		ThresholdType:     string(node.ThresholdType),
+		ThresholdValue:    node.ThresholdValue,
+		ThresholdRange:    string(node.ThresholdRange),
+		ThresholdMultiple: node.ThresholdMultiple,
+		IsTraced:          node.IsTraced,
+		IsProfiled:        node.IsProfiled,
+		Pod:               node.Pod,
+		PodNs:             node.PodNS,
+		Workload:          node.Workload,
+		WorkloadType:      node.WorkloadType,
+		IsPath:            node.IsPath,
+		IsMutated:         node.IsMutated,
+		MissVNode:         node.MissVNode,
+		SelfTime:          node.SelfTime,
+		SelfP90:           node.SelfP90,
+		MutatedValue:      node.MutatedValue,
+		SpanId:            node.SpanId,
+		Collapsed:         fromCollapsedNodes(node.Collapsed),
+		CpuBreakdown:      fromCpuBreakdown(node.CpuBreakdown),
+		NotProfiledReason: node.NotProfiledReason,
+		Children:          make([]*TraceTreeNode, 0, len(node.Children)),
+	}
+	for _, pattern := range node.CallPatterns {
+		result.CallPatterns = append(result.CallPatterns, fromCallPattern(pattern))
+	}
+	for _, child := range node.Children {
+		result.Children = append(result.Children, FromTraceTreeNode(child))
+	}
+	return result
+}
+
+// ToTraceTreeNode converts the tree and links Parent of children.
+func ToTraceTreeNode(node *TraceTreeNode) *model.TraceTreeNode {
+	if node == nil {
+		return nil
+	}
+	result := &model.TraceTreeNode{
+		Id:                node.Id,
+		InstanceKey:       node.InstanceKey,
+		ServiceName:       node.ServiceName,
+		Url:               node.Url,
+		StartTime:         node.StartTime,
+		TotalTime:         node.TotalTime,
+		ClientTime:        node.ClientTime,
+		P90:               node.P90,
+		P90Source:         model.P90Source(node.P90Source),
+		ThresholdType:     model.ThresholdType(node.ThresholdType),
+		ThresholdValue:    node.ThresholdValue,
+		ThresholdRange:    model.ThresholdRange(node.ThresholdRange),
+		ThresholdMultiple: node.ThresholdMultiple,
+		IsTraced:          node.IsTraced,
+		IsProfiled:        node.IsProfiled,
+		Pod:               node.Pod,
+		PodNS:             node.PodNs,
+		Workload:          node.Workload,
+		WorkloadType:      node.WorkloadType,
+		IsPath:            node.IsPath,
+		IsMutated:         node.IsMutated,
+		MissVNode:         node.MissVNode,
+		SelfTime:          node.SelfTime,
+		SelfP90:           node.SelfP90,
+		MutatedValue:      node.MutatedValue,
+		SpanId:            node.SpanId,
+		Collapsed:         toCollapsedNodes(node.Collapsed),
+		CpuBreakdown:      toCpuBreakdown(node.CpuBreakdown),
+		NotProfiledReason: node.NotProfiledReason,
+		Children:          make([]*model.TraceTreeNode, 0, len(node.Children)),
+	}
+	for _, pattern := range node.CallPatterns {
+		result.CallPatterns = append(result.CallPatterns, toCallPattern(pattern))
+	}
+	for _, child := range node.Children {
+		result.AddChild(ToTraceTreeNode(child))
+	}
+	return result
+}
+
+func FromErrorTreeNode(node *model.ErrorTreeNode) *ErrorTreeNode {
+	if node == nil {
+		return nil
+	}
+	result := &ErrorTreeNode{
+		Id:                node.Id,
+		InstanceKey:       node.InstanceKey,
+		ServiceName:       node.ServiceName,
+		Url:               node.Url,
+		StartTime:         node.StartTime,
+		TotalTime:         node.TotalTime,
+		P90:               node.P90,
+		ThresholdType:     string(node.ThresholdType),
+		ThresholdValue:    node.ThresholdValue,
+		ThresholdRange:    string(node.ThresholdRange),
+		ThresholdMultiple: node.ThresholdMultiple,
+		IsSampled:         node.IsSampled,
+		IsTraced:          node.IsTraced,
+		IsProfiled:        node.IsProfiled,
+		Pod:               node.Pod,
+		PodNs:             node.PodNS,
+		Workload:          node.Workload,
+		WorkloadType:      node.WorkloadType,
+		IsError:           node.IsError,
+		IsPath:            node.IsPath,
+		IsMutated:         node.IsMutated,
+		MissVNode:         node.MissVNode,
+		SpanId:            node.SpanId,
+		Depth:             int32(node.Depth),
+		NodeName:          node.NodeName,
+		Collapsed:         fromCollapsedNodes(node.Collapsed),
+		NotProfiledReason: node.NotProfiledReason,
+		Children:          make([]*ErrorTreeNode, 0, len(node.Children)),
+		ErrorSpans:        make([]*ErrorSpan, 0, len(node.ErrorSpans)),
+	}
+	for _, child := range node.Children {
+		result.Children = append(result.Children, FromErrorTreeNode(child))
+	}
+	for _, errorSpan := range node.ErrorSpans {
+		result.ErrorSpans = append(result.ErrorSpans, fromErrorSpan(errorSpan))
+	}
+	return result
+}
+
+// ToErrorTreeNode converts the tree and links Parent of children.
+func ToErrorTreeNode(node *ErrorTreeNode) *model.ErrorTreeNode {
+	if node == nil {
+		return nil
+	}
+	result := &model.ErrorTreeNode{
+		Id:                node.Id,
+		InstanceKey:       node.InstanceKey,
+		ServiceName:       node.ServiceName,
+		Url:               node.Url,
+		StartTime:         node.StartTime,
+		TotalTime:         node.TotalTime,
+		P90:               node.P90,
+		ThresholdType:     model.ThresholdType(node.ThresholdType),
+		ThresholdValue:    node.ThresholdValue,
+		ThresholdRange:    model.ThresholdRange(node.ThresholdRange),
+		ThresholdMultiple: node.ThresholdMultiple,
+		IsSampled:         node.IsSampled,
+		IsTraced:          node.IsTraced,
+		IsProfiled:        node.IsProfiled,
+		Pod:               node.Pod,
+		PodNS:             node.PodNs,
+		Workload:          node.Workload,
+		WorkloadType:      node.WorkloadType,
+		IsError:           node.IsError,
+		IsPath:            node.IsPath,
+		IsMutated:         node.IsMutated,
+		MissVNode:         node.MissVNode,
+		SpanId:            node.SpanId,
+		Depth:             int(node.Depth),
+		NodeName:          node.NodeName,
+		Collapsed:         toCollapsedNodes(node.Collapsed),
+		NotProfiledReason: node.NotProfiledReason,
+		Children:          make([]*model.ErrorTreeNode, 0, len(node.Children)),
+		ErrorSpans:        make([]*model.ErrorSpan, 0, len(node.ErrorSpans)),
+	}
+	for _, child := range node.Children {
+		result.AddChild(ToErrorTreeNode(child))
+	}
+	for _, errorSpan := range node.ErrorSpans {
+		result.ErrorSpans = append(result.ErrorSpans, toErrorSpan(errorSpan))
+	}
+	return result
+}
+
+func FromApmClientCall(call *model.ApmClientCall) *ApmClientCall {
+	if call == nil {
+		return nil
+	}
+	return &ApmClientCall{
+		ClientStartTime:  call.ClientStartTime,
+		ClientEndTime:    call.ClientEndTime,
+		ClientName:       call.ClientName,
+		ClientSpanid:     call.ClientSpanId,
+		ClientAttributes: call.ClientAttributes,
+		ServerDuration:   call.ServerDuration,
+		ServerName:       call.ServerName,
+	}
+}
+
+func ToApmClientCall(call *ApmClientCall) *model.ApmClientCall {
+	if call == nil {
+		return nil
+	}
+	return &model.ApmClientCall{
+		ClientStartTime:  call.ClientStartTime,
+		ClientEndTime:    call.ClientEndTime,
+		ClientName:       call.ClientName,
+		ClientSpanId:     call.ClientSpanid,
+		ClientAttributes: call.ClientAttributes,
+		ServerDuration:   call.ServerDuration,
+		ServerName:       call.ServerName,
+	}
+}
+
+func fromApmClientCalls(calls []*model.ApmClientCall) []*ApmClientCall {
+	result := make([]*ApmClientCall, 0, len(calls))
+	for _, call := range calls {
+		result = append(result, FromApmClientCall(call))
+	}
+	return result
+}
+
+func toApmClientCalls(calls []*ApmClientCall) []*model.ApmClientCall {
+	result := make([]*model.ApmClientCall, 0, len(calls))
+	for _, call := range calls {
+		result = append(result, ToApmClientCall(call))
+	}
+	return result
+}
+
+func fromErrorSpan(span *model.ErrorSpan) *ErrorSpan {
+	result := &ErrorSpan{
+		Name:            span.Name,
+		StartTime:       span.StartTime,
+		TotalTime:       span.TotalTime,
+		Attributes:      span.Attributes,
+		TypedAttributes: fromAttributeMap(span.TypedAttributes),
+		Exceptions:      make([]*Exception, 0, len(span.Exceptions)),
+	}
+	for _, exception := range span.Exceptions {
+		result.Exceptions = append(result.Exceptions, &Exception{
+			Timestamp: exception.Timestamp,
+			Type:      exception.Type,
+			Message:   exception.Message,
+			Stack:     exception.Stack,
+		})
+	}
+	return result
+}
+
+func toErrorSpan(span *ErrorSpan) *model.ErrorSpan {
+	result := model.NewErrorSpan(span.Name, span.StartTime, span.TotalTime)
+	for k, v := range span.Attributes {
+		result.AddAttribute(k, v)
+	}
+	if span.TypedAttributes != nil {
+		result.TypedAttributes = toAttributeMap(span.TypedAttributes)
+	}
+	for _, exception := range span.Exceptions {
+		result.Exceptions = append(result.Exceptions, model.NewOtelException(exception.Timestamp, exception.Type, exception.Message, exception.Stack))
+	}
+	return result
+}
+
+func fromAttributeMap(attributes *model.AttributeMap) map[string]*AttributeValue {
+	if attributes == nil {
+		return nil
+	}
+	result := make(map[string]*AttributeValue, attributes.Size())
+	attributes.Range(func(key string, value model.AttributeValue) bool {
+		switch raw := model.AttributeValueAsRaw(value).(type) {
+		case string:
+			result[key] = &AttributeValue{Value: &AttributeValue_StringValue{StringValue: raw}}
+		case int64:
+			result[key] = &AttributeValue{Value: &AttributeValue_IntValue{IntValue: raw}}
+		case bool:
+			result[key] = &AttributeValue{Value: &AttributeValue_BoolValue{BoolValue: raw}}
+		case float64:
+			result[key] = &AttributeValue{Value: &AttributeValue_DoubleValue{DoubleValue: raw}}
+		case []byte:
+			result[key] = &AttributeValue{Value: &AttributeValue_BytesValue{BytesValue: raw}}
+		case []string:
+			result[key] = &AttributeValue{Value: &AttributeValue_StringArrayValue{StringArrayValue: &AttributeValue_StringArray{Values: raw}}}
+		case []int64:
+			result[key] = &AttributeValue{Value: &AttributeValue_IntArrayValue{IntArrayValue: &AttributeValue_IntArray{Values: raw}}}
+		case []float64:
+			result[key] = &AttributeValue{Value: &AttributeValue_DoubleArrayValue{DoubleArrayValue: &AttributeValue_DoubleArray{Values: raw}}}
+		case []bool:
+			result[key] = &AttributeValue{Value: &AttributeValue_BoolArrayValue{BoolArrayValue: &AttributeValue_BoolArray{Values: raw}}}
+		}
+		return true
+	})
+	return result
+}
+
+func toAttributeMap(attributes map[string]*AttributeValue) *model.AttributeMap {
+	result := model.NewAttributeMap()
+	for key, value := range attributes {
+		switch v := value.GetValue().(type) {
+		case *AttributeValue_StringValue:
+			result.AddStringValue(key, v.StringValue)
+		case *AttributeValue_IntValue:
+			result.AddIntValue(key, v.IntValue)
+		case *AttributeValue_BoolValue:
+			result.AddBoolValue(key, v.BoolValue)
+		case *AttributeValue_DoubleValue:
+			result.AddDoubleValue(key, v.DoubleValue)
+		case *AttributeValue_BytesValue:
+			result.AddBytesValue(key, v.BytesValue)
+		case *AttributeValue_StringArrayValue:
+			result.AddStringArrayValue(key, v.StringArrayValue.GetValues())
+		case *AttributeValue_IntArrayValue:
+			result.AddIntArrayValue(key, v.IntArrayValue.GetValues())
+		case *AttributeValue_DoubleArrayValue:
+			result.AddDoubleArrayValue(key, v.DoubleArrayValue.GetValues())
+		case *AttributeValue_BoolArrayValue:
+			result.AddBoolArrayValue(key, v.BoolArrayValue.GetValues())
+		}
+	}
+	return result
+}
+
+func fromCallPattern(pattern *model.CallPattern) *CallPattern {
+	return &CallPattern{
+		Type:       string(pattern.Type),
+		ReqType:    pattern.ReqType,
+		Target:     pattern.Target,
+		Count:      int32(pattern.Count),
+		ErrorCount: int32(pattern.ErrorCount),
+		TotalTime:  pattern.TotalTime,
+		MaxTime:    pattern.MaxTime,
+		SavingTime: pattern.SavingTime,
+		Message:    pattern.Message,
+		SpanIds:    pattern.SpanIds,
+	}
+}
+
+func toCallPattern(pattern *CallPattern) *model.CallPattern {
+	return &model.CallPattern{
+		Type:       model.CallPatternType(pattern.Type),
+		ReqType:    pattern.ReqType,
+		Target:     pattern.Target,
+		Count:      int(pattern.Count),
+		ErrorCount: int(pattern.ErrorCount),
+		TotalTime:  pattern.TotalTime,
+		MaxTime:    pattern.MaxTime,
+		SavingTime: pattern.SavingTime,
+		Message:    pattern.Message,
+		SpanIds:    pattern.SpanIds,
+	}
+}
+
+func fromCollapsedNodes(collapsed *model.CollapsedNodes) *CollapsedNodes {
+	if collapsed == nil {
+		return nil
+	}
+	return &CollapsedNodes{
+		Count:       int32(collapsed.Count),
+		MinTime:     collapsed.MinTime,
+		MaxTime:     collapsed.MaxTime,
+		AvgTime:     collapsed.AvgTime,
+		SumTime:     collapsed.SumTime,
+		ElidedNodes: int32(collapsed.ElidedNodes),
+	}
+}
+
+func toCollapsedNodes(collapsed *CollapsedNodes) *model.CollapsedNodes {
+	if collapsed == nil {
+		return nil
+	}
+	return &model.CollapsedNodes{
+		Count:       int(collapsed.Count),
+		MinTime:     collapsed.MinTime,
+		MaxTime:     collapsed.MaxTime,
+		AvgTime:     collapsed.AvgTime,
+		SumTime:     collapsed.SumTime,
+		ElidedNodes: int(collapsed.ElidedNodes),
+	}
+}
+
+func fromCpuBreakdown(breakdown *model.CpuBreakdown) *CpuBreakdown {
+	if breakdown == nil {
+		return nil
+	}
+	return &CpuBreakdown{
+		Tid:          breakdown.Tid,
+		ThreadName:   breakdown.ThreadName,
+		StartTime:    breakdown.StartTime,
+		EndTime:      breakdown.EndTime,
+		ObservedTime: breakdown.ObservedTime,
+		Times:        breakdown.Times,
+		Verdict:      string(breakdown.Verdict),
+		Message:      breakdown.Message,
+	}
+}
+
+func toCpuBreakdown(breakdown *CpuBreakdown) *model.CpuBreakdown {
+	if breakdown == nil {
+		return nil
+	}
+	return &model.CpuBreakdown{
+		Tid:          breakdown.Tid,
+		ThreadName:   breakdown.ThreadName,
+		StartTime:    breakdown.StartTime,
+		EndTime:      breakdown.EndTime,
+		ObservedTime: breakdown.ObservedTime,
+		Times:        breakdown.Times,
+		Verdict:      model.SlowVerdict(breakdown.Verdict),
+		Message:      breakdown.Message,
+	}
+}
+
+func fromGcCorrelation(correlation *model.GcCorrelation) *GcCorrelation {
+	if correlation == nil {
+		return nil
+	}
+	result := &GcCorrelation{
+		Pauses:         make([]*GcPause, 0, len(correlation.Pauses)),
+		SelfTime:       correlation.SelfTime,
+		OverlapTime:    correlation.OverlapTime,
+		OverlapPercent: correlation.OverlapPercent,
+		IsGcInduced:    correlation.IsGcInduced,
+	}
+	for _, pause := range correlation.Pauses {
+		result.Pauses = append(result.Pauses, &GcPause{
+			Type:        pause.Type,
+			StartTime:   pause.StartTime,
+			Duration:    pause.Duration,
+			OverlapTime: pause.OverlapTime,
+		})
+	}
+	return result
+}
+
+func toGcCorrelation(correlation *GcCorrelation) *model.GcCorrelation {
+	if correlation == nil {
+		return nil
+	}
+	result := &model.GcCorrelation{
+		Pauses:         make([]*model.GcPause, 0, len(correlation.Pauses)),
+		SelfTime:       correlation.SelfTime,
+		OverlapTime:    correlation.OverlapTime,
+		OverlapPercent: correlation.OverlapPercent,
+		IsGcInduced:    correlation.IsGcInduced,
+	}
+	for _, pause := range correlation.Pauses {
+		result.Pauses = append(result.Pauses, &model.GcPause{
+			Type:        pause.Type,
+			StartTime:   pause.StartTime,
+			Duration:    pause.Duration,
+			OverlapTime: pause.OverlapTime,
+		})
+	}
+	return result
+}
+
+func fromLockContentionSummary(summary *model.LockContentionSummary) *LockContentionSummary {
+	if summary == nil {
+		return nil
+	}
+	result := &LockContentionSummary{
+		StartTime:        summary.StartTime,
+		EndTime:          summary.EndTime,
+		LockCount:        int32(summary.LockCount),
+		TotalBlockedTime: summary.TotalBlockedTime,
+		ThreadTime:       summary.ThreadTime,
+		BlockedPercent:   summary.BlockedPercent,
+		TopLocks:         make([]*LockContention, 0, len(summary.TopLocks)),
+	}
+	for _, lock := range summary.TopLocks {
+		result.TopLocks = append(result.TopLocks, &LockContention{
+			Lock:          lock.Lock,
+			WaitCount:     int32(lock.WaitCount),
+			TotalWaitTime: lock.TotalWaitTime,
+			MaxWaitTime:   lock.MaxWaitTime,
+			Owners:        lock.Owners,
+			Waiters:       lock.Waiters,
+		})
+	}
+	return result
+}
+
+func toLockContentionSummary(summary *LockContentionSummary) *model.LockContentionSummary {
+	if summary == nil {
+		return nil
+	}
+	result := &model.LockContentionSummary{
+		StartTime:        summary.StartTime,
+		EndTime:          summary.EndTime,
+		LockCount:        int(summary.LockCount),
+		TotalBlockedTime: summary.TotalBlockedTime,
+		ThreadTime:       summary.ThreadTime,
+		BlockedPercent:   summary.BlockedPercent,
+		TopLocks:         make([]*model.LockContention, 0, len(summary.TopLocks)),
+	}
+	for _, lock := range summary.TopLocks {
+		result.TopLocks = append(result.TopLocks, &model.LockContention{
+			Lock:          lock.Lock,
+			WaitCount:     int(lock.WaitCount),
+			TotalWaitTime: lock.TotalWaitTime,
+			MaxWaitTime:   lock.MaxWaitTime,
+			Owners:        lock.Owners,
+			Waiters:       lock.Waiters,
+		})
+	}
+	return result
+}
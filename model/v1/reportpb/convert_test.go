@@ -0,0 +1,139 @@
+package reportpb
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/CloudDetail/apo-module/model/v1"
+)
+
+// TestConvertAllFields fills every exported field of the reports and checks they survive the conversion,
+// a new field of model which is not converted fails this test.
+func TestConvertAllFields(t *testing.T) {
+	tests := []struct {
+		name      string
+		report    interface{}
+		roundTrip func(report interface{}) interface{}
+	}{
+		{"CameraNodeReport", &model.CameraNodeReport{}, func(report interface{}) interface{} {
+			return ToCameraNodeReport(FromCameraNodeReport(report.(*model.CameraNodeReport)))
+		}},
+		{"ErrorReport", &model.ErrorReport{}, func(report interface{}) interface{} {
+			return ToErrorReport(FromErrorReport(report.(*model.ErrorReport)))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filler := &fieldFiller{}
+			filler.fill(reflect.ValueOf(tt.report).Elem(), 0)
+			// SchemaVersion is always the latest.
+			reflect.ValueOf(tt.report).Elem().FieldByName("SchemaVersion").SetInt(model.ReportSchemaVersion)
+
+			got := tt.roundTrip(tt.report)
+			diffs := make([]string, 0)
+			diffFields(tt.name, reflect.ValueOf(tt.report), reflect.ValueOf(got), &diffs)
+			for _, diff := range diffs {
+				t.Errorf("field is not converted: %s", diff)
+			}
+		})
+	}
+}
+
+// fieldFiller sets different non-zero values to fields, so swapped fields are also found.
+type fieldFiller struct {
+	next int
+}
+
+func (f *fieldFiller) fill(v reflect.Value, depth int) {
+	f.next++
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(fmt.Sprintf("s%d", f.next))
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		v.SetInt(int64(f.next))
+	case reflect.Uint, reflect.Uint8, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(f.next))
+	case reflect.Float64:
+		v.SetFloat(float64(f.next) + 0.5)
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			f.fill(v.Index(i), depth)
+		}
+	case reflect.Slice:
+		// Children are filled for 2 levels.
+		if depth > 2 {
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		f.fill(v.Index(0), depth+1)
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		value := reflect.New(v.Type().Elem()).Elem()
+		f.fill(key, depth)
+		f.fill(value, depth)
+		v.SetMapIndex(key, value)
+	case reflect.Pointer:
+		if v.Type() == reflect.TypeOf(&model.AttributeMap{}) {
+			attributes := model.NewAttributeMap()
+			attributes.AddIntValue(fmt.Sprintf("k%d", f.next), int64(f.next))
+			v.Set(reflect.ValueOf(attributes))
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		f.fill(v.Elem(), depth+1)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if isConvertedField(v.Type().Field(i)) {
+				f.fill(v.Field(i), depth)
+			}
+		}
+	}
+}
+
+// isConvertedField skips unexported fields and fields not kept in json like Parent.
+func isConvertedField(field reflect.StructField) bool {
+	return field.IsExported() && field.Tag.Get("json") != "-"
+}
+
+func diffFields(path string, want reflect.Value, got reflect.Value, diffs *[]string) {
+	switch want.Kind() {
+	case reflect.Pointer:
+		if want.IsNil() {
+			return
+		}
+		if got.IsNil() {
+			*diffs = append(*diffs, path)
+			return
+		}
+		if want.Type() == reflect.TypeOf(&model.AttributeMap{}) {
+			if want.Interface().(*model.AttributeMap).String() != got.Interface().(*model.AttributeMap).String() {
+				*diffs = append(*diffs, path)
+			}
+			return
+		}
+		diffFields(path, want.Elem(), got.Elem(), diffs)
+	case reflect.Struct:
+		for i := 0; i < want.NumField(); i++ {
+			if field := want.Type().Field(i); isConvertedField(field) {
+				diffFields(path+"."+field.Name, want.Field(i), got.Field(i), diffs)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if got.Len() != want.Len() {
+			*diffs = append(*diffs, path)
+			return
+		}
+		for i := 0; i < want.Len(); i++ {
+			diffFields(fmt.Sprintf("%s[%d]", path, i), want.Index(i), got.Index(i), diffs)
+		}
+	default:
+		if !reflect.DeepEqual(want.Interface(), got.Interface()) {
+			*diffs = append(*diffs, fmt.Sprintf("%s = %v, want %v", strings.TrimPrefix(path, "."), got.Interface(), want.Interface()))
+		}
+	}
+}
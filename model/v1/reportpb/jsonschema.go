@@ -0,0 +1,99 @@
+package reportpb
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema generates the JSON Schema of message for the documents encoded by encoding/json from model,
+// 64-bit integers are numbers and nil pointers, slices and maps are null.
+func JSONSchema(message protoreflect.MessageDescriptor) ([]byte, error) {
+	defs := make(map[string]interface{})
+	schema := messageSchema(message, defs)
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = string(message.Name())
+	delete(defs, string(message.Name()))
+	if len(defs) > 0 {
+		schema["$defs"] = defs
+	}
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+func CameraNodeReportJSONSchema() ([]byte, error) {
+	return JSONSchema((&CameraNodeReport{}).ProtoReflect().Descriptor())
+}
+
+func ErrorReportJSONSchema() ([]byte, error) {
+	return JSONSchema((&ErrorReport{}).ProtoReflect().Descriptor())
+}
+
+func messageSchema(message protoreflect.MessageDescriptor, defs map[string]interface{}) map[string]interface{} {
+	properties := make(map[string]interface{})
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		properties[field.JSONName()] = fieldSchema(field, defs)
+	}
+	return map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+}
+
+func fieldSchema(field protoreflect.FieldDescriptor, defs map[string]interface{}) map[string]interface{} {
+	if field.IsMap() {
+		return map[string]interface{}{
+			"type":                 []string{"object", "null"},
+			"additionalProperties": valueSchema(field.MapValue(), defs),
+		}
+	}
+	if field.IsList() {
+		return map[string]interface{}{
+			"type":  []string{"array", "null"},
+			"items": valueSchema(field, defs),
+		}
+	}
+	schema := valueSchema(field, defs)
+	if field.Kind() == protoreflect.MessageKind && len(schema) > 0 {
+		return map[string]interface{}{
+			"anyOf": []interface{}{schema, map[string]interface{}{"type": "null"}},
+		}
+	}
+	return schema
+}
+
+func valueSchema(field protoreflect.FieldDescriptor, defs map[string]interface{}) map[string]interface{} {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return map[string]interface{}{"type": "string"}
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return map[string]interface{}{"type": "integer"}
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return map[string]interface{}{"type": "integer", "minimum": 0}
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number"}
+	case protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string", "contentEncoding": "base64"}
+	case protoreflect.EnumKind:
+		return map[string]interface{}{"type": "string"}
+	case protoreflect.MessageKind:
+		message := field.Message()
+		// AttributeValue is encoded as the raw value.
+		if message.FullName() == (&AttributeValue{}).ProtoReflect().Descriptor().FullName() {
+			return map[string]interface{}{}
+		}
+		name := string(message.Name())
+		if _, exist := defs[name]; !exist {
+			defs[name] = nil // Placeholder for recursive message.
+			defs[name] = messageSchema(message, defs)
+		}
+		return map[string]interface{}{"$ref": "#/$defs/" + name}
+	}
+	return map[string]interface{}{}
+}
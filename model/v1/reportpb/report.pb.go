@@ -0,0 +1,3362 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: report.proto
+
+package reportpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CameraNodeReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaVersion int32                 `protobuf:"varint,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	Timestamp     uint64                `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TraceId       string                `protobuf:"bytes,3,opt,name=trace_id,proto3" json:"trace_id,omitempty"`
+	Duration      uint64                `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Data          *CameraNodeReportData `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CameraNodeReport) Reset() {
+	*x = CameraNodeReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CameraNodeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CameraNodeReport) ProtoMessage() {}
+
+func (x *CameraNodeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CameraNodeReport.ProtoReflect.Descriptor instead.
+func (*CameraNodeReport) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CameraNodeReport) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *CameraNodeReport) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CameraNodeReport) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *CameraNodeReport) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CameraNodeReport) GetData() *CameraNodeReportData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CameraNodeReportData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryService        string                 `protobuf:"bytes,1,opt,name=entry_service,proto3" json:"entry_service,omitempty"`
+	EntryInstance       string                 `protobuf:"bytes,2,opt,name=entry_instance,proto3" json:"entry_instance,omitempty"`
+	MutatedService      string                 `protobuf:"bytes,3,opt,name=mutated_service,proto3" json:"mutated_service,omitempty"`
+	MutatedInstance     string                 `protobuf:"bytes,4,opt,name=mutated_instance,proto3" json:"mutated_instance,omitempty"`
+	MutatedUrl          string                 `protobuf:"bytes,5,opt,name=mutated_url,proto3" json:"mutated_url,omitempty"`
+	SpanId              string                 `protobuf:"bytes,6,opt,name=span_id,proto3" json:"span_id,omitempty"`
+	MutatedPod          string                 `protobuf:"bytes,7,opt,name=mutated_pod,proto3" json:"mutated_pod,omitempty"`
+	MutatedPodNs        string                 `protobuf:"bytes,8,opt,name=mutated_pod_ns,proto3" json:"mutated_pod_ns,omitempty"`
+	MutatedWorkloadName string                 `protobuf:"bytes,9,opt,name=mutated_workload_name,proto3" json:"mutated_workload_name,omitempty"`
+	MutatedWorkloadType string                 `protobuf:"bytes,10,opt,name=mutated_workload_type,proto3" json:"mutated_workload_type,omitempty"`
+	Cause               string                 `protobuf:"bytes,11,opt,name=cause,proto3" json:"cause,omitempty"`
+	ContentKey          string                 `protobuf:"bytes,12,opt,name=content_key,proto3" json:"content_key,omitempty"`
+	RelationTrees       *TraceTreeNode         `protobuf:"bytes,13,opt,name=relation_trees,proto3" json:"relation_trees,omitempty"`
+	OtelClientCalls     []*ApmClientCall       `protobuf:"bytes,14,rep,name=otel_client_calls,proto3" json:"otel_client_calls,omitempty"`
+	ElidedNodes         int32                  `protobuf:"varint,15,opt,name=elided_nodes,proto3" json:"elided_nodes,omitempty"`
+	CpuBreakdown        *CpuBreakdown          `protobuf:"bytes,16,opt,name=cpu_breakdown,proto3" json:"cpu_breakdown,omitempty"`
+	SlowVerdict         string                 `protobuf:"bytes,17,opt,name=slow_verdict,proto3" json:"slow_verdict,omitempty"`
+	GcCorrelation       *GcCorrelation         `protobuf:"bytes,18,opt,name=gc_correlation,proto3" json:"gc_correlation,omitempty"`
+	LockContention      *LockContentionSummary `protobuf:"bytes,19,opt,name=lock_contention,proto3" json:"lock_contention,omitempty"`
+	ThresholdType       string                 `protobuf:"bytes,20,opt,name=threshold_type,proto3" json:"threshold_type,omitempty"`
+	ThresholdValue      float64                `protobuf:"fixed64,21,opt,name=threshold_value,proto3" json:"threshold_value,omitempty"`
+	ThresholdRange      string                 `protobuf:"bytes,22,opt,name=threshold_range,proto3" json:"threshold_range,omitempty"`
+	ThresholdMultiple   float64                `protobuf:"fixed64,23,opt,name=threshold_multiple,proto3" json:"threshold_multiple,omitempty"`
+	EntryInstanceKey    string                 `protobuf:"bytes,24,opt,name=entry_instance_key,proto3" json:"entry_instance_key,omitempty"`
+	MutatedInstanceKey  string                 `protobuf:"bytes,25,opt,name=mutated_instance_key,proto3" json:"mutated_instance_key,omitempty"`
+	// Deprecated: relation_trees and otel_client_calls as json string, will be removed in next release.
+	//
+	// Deprecated: Marked as deprecated in report.proto.
+	Relation string `protobuf:"bytes,100,opt,name=relation,proto3" json:"relation,omitempty"`
+	// Deprecated: Marked as deprecated in report.proto.
+	ClientCalls string `protobuf:"bytes,101,opt,name=client_calls,proto3" json:"client_calls,omitempty"`
+}
+
+func (x *CameraNodeReportData) Reset() {
+	*x = CameraNodeReportData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CameraNodeReportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CameraNodeReportData) ProtoMessage() {}
+
+func (x *CameraNodeReportData) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CameraNodeReportData.ProtoReflect.Descriptor instead.
+func (*CameraNodeReportData) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CameraNodeReportData) GetEntryService() string {
+	if x != nil {
+		return x.EntryService
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetEntryInstance() string {
+	if x != nil {
+		return x.EntryInstance
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetMutatedService() string {
+	if x != nil {
+		return x.MutatedService
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetMutatedInstance() string {
+	if x != nil {
+		return x.MutatedInstance
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetMutatedUrl() string {
+	if x != nil {
+		return x.MutatedUrl
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetSpanId() string {
+	if x != nil {
+		return x.SpanId
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetMutatedPod() string {
+	if x != nil {
+		return x.MutatedPod
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetMutatedPodNs() string {
+	if x != nil {
+		return x.MutatedPodNs
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetMutatedWorkloadName() string {
+	if x != nil {
+		return x.MutatedWorkloadName
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetMutatedWorkloadType() string {
+	if x != nil {
+		return x.MutatedWorkloadType
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetContentKey() string {
+	if x != nil {
+		return x.ContentKey
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetRelationTrees() *TraceTreeNode {
+	if x != nil {
+		return x.RelationTrees
+	}
+	return nil
+}
+
+func (x *CameraNodeReportData) GetOtelClientCalls() []*ApmClientCall {
+	if x != nil {
+		return x.OtelClientCalls
+	}
+	return nil
+}
+
+func (x *CameraNodeReportData) GetElidedNodes() int32 {
+	if x != nil {
+		return x.ElidedNodes
+	}
+	return 0
+}
+
+func (x *CameraNodeReportData) GetCpuBreakdown() *CpuBreakdown {
+	if x != nil {
+		return x.CpuBreakdown
+	}
+	return nil
+}
+
+func (x *CameraNodeReportData) GetSlowVerdict() string {
+	if x != nil {
+		return x.SlowVerdict
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetGcCorrelation() *GcCorrelation {
+	if x != nil {
+		return x.GcCorrelation
+	}
+	return nil
+}
+
+func (x *CameraNodeReportData) GetLockContention() *LockContentionSummary {
+	if x != nil {
+		return x.LockContention
+	}
+	return nil
+}
+
+func (x *CameraNodeReportData) GetThresholdType() string {
+	if x != nil {
+		return x.ThresholdType
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetThresholdValue() float64 {
+	if x != nil {
+		return x.ThresholdValue
+	}
+	return 0
+}
+
+func (x *CameraNodeReportData) GetThresholdRange() string {
+	if x != nil {
+		return x.ThresholdRange
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetThresholdMultiple() float64 {
+	if x != nil {
+		return x.ThresholdMultiple
+	}
+	return 0
+}
+
+func (x *CameraNodeReportData) GetEntryInstanceKey() string {
+	if x != nil {
+		return x.EntryInstanceKey
+	}
+	return ""
+}
+
+func (x *CameraNodeReportData) GetMutatedInstanceKey() string {
+	if x != nil {
+		return x.MutatedInstanceKey
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in report.proto.
+func (x *CameraNodeReportData) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in report.proto.
+func (x *CameraNodeReportData) GetClientCalls() string {
+	if x != nil {
+		return x.ClientCalls
+	}
+	return ""
+}
+
+type ErrorReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SchemaVersion int32            `protobuf:"varint,1,opt,name=schema_version,proto3" json:"schema_version,omitempty"`
+	Name          string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Timestamp     uint64           `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TraceId       string           `protobuf:"bytes,4,opt,name=trace_id,proto3" json:"trace_id,omitempty"`
+	IsDrop        bool             `protobuf:"varint,5,opt,name=is_drop,proto3" json:"is_drop,omitempty"`
+	Duration      uint64           `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Data          *ErrorReportData `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ErrorReport) Reset() {
+	*x = ErrorReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorReport) ProtoMessage() {}
+
+func (x *ErrorReport) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorReport.ProtoReflect.Descriptor instead.
+func (*ErrorReport) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ErrorReport) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *ErrorReport) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ErrorReport) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ErrorReport) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *ErrorReport) GetIsDrop() bool {
+	if x != nil {
+		return x.IsDrop
+	}
+	return false
+}
+
+func (x *ErrorReport) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ErrorReport) GetData() *ErrorReportData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ErrorReportData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryService        string         `protobuf:"bytes,1,opt,name=entry_service,proto3" json:"entry_service,omitempty"`
+	EntryInstance       string         `protobuf:"bytes,2,opt,name=entry_instance,proto3" json:"entry_instance,omitempty"`
+	MutatedService      string         `protobuf:"bytes,3,opt,name=mutated_service,proto3" json:"mutated_service,omitempty"`
+	MutatedInstance     string         `protobuf:"bytes,4,opt,name=mutated_instance,proto3" json:"mutated_instance,omitempty"`
+	MutatedUrl          string         `protobuf:"bytes,5,opt,name=mutated_url,proto3" json:"mutated_url,omitempty"`
+	SpanId              string         `protobuf:"bytes,6,opt,name=span_id,proto3" json:"span_id,omitempty"`
+	MutatedPod          string         `protobuf:"bytes,7,opt,name=mutated_pod,proto3" json:"mutated_pod,omitempty"`
+	MutatedPodNs        string         `protobuf:"bytes,8,opt,name=mutated_pod_ns,proto3" json:"mutated_pod_ns,omitempty"`
+	MutatedWorkloadName string         `protobuf:"bytes,9,opt,name=mutated_workload_name,proto3" json:"mutated_workload_name,omitempty"`
+	MutatedWorkloadType string         `protobuf:"bytes,10,opt,name=mutated_workload_type,proto3" json:"mutated_workload_type,omitempty"`
+	ContentKey          string         `protobuf:"bytes,11,opt,name=content_key,proto3" json:"content_key,omitempty"`
+	Cause               string         `protobuf:"bytes,12,opt,name=cause,proto3" json:"cause,omitempty"`
+	CauseMessage        string         `protobuf:"bytes,13,opt,name=cause_message,proto3" json:"cause_message,omitempty"`
+	RelationTrees       *ErrorTreeNode `protobuf:"bytes,14,opt,name=relation_trees,proto3" json:"relation_trees,omitempty"`
+	ElidedNodes         int32          `protobuf:"varint,15,opt,name=elided_nodes,proto3" json:"elided_nodes,omitempty"`
+	ThresholdType       string         `protobuf:"bytes,16,opt,name=threshold_type,proto3" json:"threshold_type,omitempty"`
+	ThresholdValue      float64        `protobuf:"fixed64,17,opt,name=threshold_value,proto3" json:"threshold_value,omitempty"`
+	ThresholdRange      string         `protobuf:"bytes,18,opt,name=threshold_range,proto3" json:"threshold_range,omitempty"`
+	ThresholdMultiple   float64        `protobuf:"fixed64,19,opt,name=threshold_multiple,proto3" json:"threshold_multiple,omitempty"`
+	EntryInstanceKey    string         `protobuf:"bytes,20,opt,name=entry_instance_key,proto3" json:"entry_instance_key,omitempty"`
+	MutatedInstanceKey  string         `protobuf:"bytes,21,opt,name=mutated_instance_key,proto3" json:"mutated_instance_key,omitempty"`
+}
+
+func (x *ErrorReportData) Reset() {
+	*x = ErrorReportData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorReportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorReportData) ProtoMessage() {}
+
+func (x *ErrorReportData) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorReportData.ProtoReflect.Descriptor instead.
+func (*ErrorReportData) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ErrorReportData) GetEntryService() string {
+	if x != nil {
+		return x.EntryService
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetEntryInstance() string {
+	if x != nil {
+		return x.EntryInstance
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetMutatedService() string {
+	if x != nil {
+		return x.MutatedService
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetMutatedInstance() string {
+	if x != nil {
+		return x.MutatedInstance
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetMutatedUrl() string {
+	if x != nil {
+		return x.MutatedUrl
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetSpanId() string {
+	if x != nil {
+		return x.SpanId
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetMutatedPod() string {
+	if x != nil {
+		return x.MutatedPod
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetMutatedPodNs() string {
+	if x != nil {
+		return x.MutatedPodNs
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetMutatedWorkloadName() string {
+	if x != nil {
+		return x.MutatedWorkloadName
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetMutatedWorkloadType() string {
+	if x != nil {
+		return x.MutatedWorkloadType
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetContentKey() string {
+	if x != nil {
+		return x.ContentKey
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetCauseMessage() string {
+	if x != nil {
+		return x.CauseMessage
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetRelationTrees() *ErrorTreeNode {
+	if x != nil {
+		return x.RelationTrees
+	}
+	return nil
+}
+
+func (x *ErrorReportData) GetElidedNodes() int32 {
+	if x != nil {
+		return x.ElidedNodes
+	}
+	return 0
+}
+
+func (x *ErrorReportData) GetThresholdType() string {
+	if x != nil {
+		return x.ThresholdType
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetThresholdValue() float64 {
+	if x != nil {
+		return x.ThresholdValue
+	}
+	return 0
+}
+
+func (x *ErrorReportData) GetThresholdRange() string {
+	if x != nil {
+		return x.ThresholdRange
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetThresholdMultiple() float64 {
+	if x != nil {
+		return x.ThresholdMultiple
+	}
+	return 0
+}
+
+func (x *ErrorReportData) GetEntryInstanceKey() string {
+	if x != nil {
+		return x.EntryInstanceKey
+	}
+	return ""
+}
+
+func (x *ErrorReportData) GetMutatedInstanceKey() string {
+	if x != nil {
+		return x.MutatedInstanceKey
+	}
+	return ""
+}
+
+type TraceTreeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceName       string           `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Url               string           `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	StartTime         uint64           `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TotalTime         uint64           `protobuf:"varint,5,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	ClientTime        uint64           `protobuf:"varint,6,opt,name=client_time,json=clientTime,proto3" json:"client_time,omitempty"`
+	P90               uint64           `protobuf:"varint,7,opt,name=p90,proto3" json:"p90,omitempty"`
+	P90Source         string           `protobuf:"bytes,8,opt,name=p90_source,json=p90Source,proto3" json:"p90_source,omitempty"`
+	ThresholdType     string           `protobuf:"bytes,9,opt,name=threshold_type,proto3" json:"threshold_type,omitempty"`
+	ThresholdValue    float64          `protobuf:"fixed64,10,opt,name=threshold_value,proto3" json:"threshold_value,omitempty"`
+	ThresholdRange    string           `protobuf:"bytes,11,opt,name=threshold_range,proto3" json:"threshold_range,omitempty"`
+	ThresholdMultiple float64          `protobuf:"fixed64,12,opt,name=threshold_multiple,proto3" json:"threshold_multiple,omitempty"`
+	IsTraced          bool             `protobuf:"varint,13,opt,name=is_traced,json=isTraced,proto3" json:"is_traced,omitempty"`
+	IsProfiled        bool             `protobuf:"varint,14,opt,name=is_profiled,json=isProfiled,proto3" json:"is_profiled,omitempty"`
+	Pod               string           `protobuf:"bytes,15,opt,name=pod,proto3" json:"pod,omitempty"`
+	PodNs             string           `protobuf:"bytes,16,opt,name=pod_ns,json=podNS,proto3" json:"pod_ns,omitempty"`
+	Workload          string           `protobuf:"bytes,17,opt,name=workload,proto3" json:"workload,omitempty"`
+	WorkloadType      string           `protobuf:"bytes,18,opt,name=workload_type,json=workloadType,proto3" json:"workload_type,omitempty"`
+	IsPath            bool             `protobuf:"varint,19,opt,name=is_path,json=isPath,proto3" json:"is_path,omitempty"`
+	IsMutated         bool             `protobuf:"varint,20,opt,name=is_mutated,json=isMutated,proto3" json:"is_mutated,omitempty"`
+	MissVNode         bool             `protobuf:"varint,21,opt,name=miss_v_node,json=missVNode,proto3" json:"miss_v_node,omitempty"`
+	SelfTime          uint64           `protobuf:"varint,22,opt,name=self_time,json=selfTime,proto3" json:"self_time,omitempty"`
+	SelfP90           uint64           `protobuf:"varint,23,opt,name=self_p90,json=selfP90,proto3" json:"self_p90,omitempty"`
+	MutatedValue      int64            `protobuf:"varint,24,opt,name=mutated_value,json=mutatedValue,proto3" json:"mutated_value,omitempty"`
+	SpanId            string           `protobuf:"bytes,25,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	CallPatterns      []*CallPattern   `protobuf:"bytes,26,rep,name=call_patterns,json=callPatterns,proto3" json:"call_patterns,omitempty"`
+	Collapsed         *CollapsedNodes  `protobuf:"bytes,27,opt,name=collapsed,proto3" json:"collapsed,omitempty"`
+	CpuBreakdown      *CpuBreakdown    `protobuf:"bytes,28,opt,name=cpu_breakdown,json=cpuBreakdown,proto3" json:"cpu_breakdown,omitempty"`
+	Children          []*TraceTreeNode `protobuf:"bytes,29,rep,name=children,proto3" json:"children,omitempty"`
+	NotProfiledReason string           `protobuf:"bytes,30,opt,name=not_profiled_reason,json=notProfiledReason,proto3" json:"not_profiled_reason,omitempty"`
+	InstanceKey       string           `protobuf:"bytes,31,opt,name=instance_key,json=instanceKey,proto3" json:"instance_key,omitempty"`
+}
+
+func (x *TraceTreeNode) Reset() {
+	*x = TraceTreeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceTreeNode) ProtoMessage() {}
+
+func (x *TraceTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceTreeNode.ProtoReflect.Descriptor instead.
+func (*TraceTreeNode) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TraceTreeNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *TraceTreeNode) GetTotalTime() uint64 {
+	if x != nil {
+		return x.TotalTime
+	}
+	return 0
+}
+
+func (x *TraceTreeNode) GetClientTime() uint64 {
+	if x != nil {
+		return x.ClientTime
+	}
+	return 0
+}
+
+func (x *TraceTreeNode) GetP90() uint64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+func (x *TraceTreeNode) GetP90Source() string {
+	if x != nil {
+		return x.P90Source
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetThresholdType() string {
+	if x != nil {
+		return x.ThresholdType
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetThresholdValue() float64 {
+	if x != nil {
+		return x.ThresholdValue
+	}
+	return 0
+}
+
+func (x *TraceTreeNode) GetThresholdRange() string {
+	if x != nil {
+		return x.ThresholdRange
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetThresholdMultiple() float64 {
+	if x != nil {
+		return x.ThresholdMultiple
+	}
+	return 0
+}
+
+func (x *TraceTreeNode) GetIsTraced() bool {
+	if x != nil {
+		return x.IsTraced
+	}
+	return false
+}
+
+func (x *TraceTreeNode) GetIsProfiled() bool {
+	if x != nil {
+		return x.IsProfiled
+	}
+	return false
+}
+
+func (x *TraceTreeNode) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetPodNs() string {
+	if x != nil {
+		return x.PodNs
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetWorkload() string {
+	if x != nil {
+		return x.Workload
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetWorkloadType() string {
+	if x != nil {
+		return x.WorkloadType
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetIsPath() bool {
+	if x != nil {
+		return x.IsPath
+	}
+	return false
+}
+
+func (x *TraceTreeNode) GetIsMutated() bool {
+	if x != nil {
+		return x.IsMutated
+	}
+	return false
+}
+
+func (x *TraceTreeNode) GetMissVNode() bool {
+	if x != nil {
+		return x.MissVNode
+	}
+	return false
+}
+
+func (x *TraceTreeNode) GetSelfTime() uint64 {
+	if x != nil {
+		return x.SelfTime
+	}
+	return 0
+}
+
+func (x *TraceTreeNode) GetSelfP90() uint64 {
+	if x != nil {
+		return x.SelfP90
+	}
+	return 0
+}
+
+func (x *TraceTreeNode) GetMutatedValue() int64 {
+	if x != nil {
+		return x.MutatedValue
+	}
+	return 0
+}
+
+func (x *TraceTreeNode) GetSpanId() string {
+	if x != nil {
+		return x.SpanId
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetCallPatterns() []*CallPattern {
+	if x != nil {
+		return x.CallPatterns
+	}
+	return nil
+}
+
+func (x *TraceTreeNode) GetCollapsed() *CollapsedNodes {
+	if x != nil {
+		return x.Collapsed
+	}
+	return nil
+}
+
+func (x *TraceTreeNode) GetCpuBreakdown() *CpuBreakdown {
+	if x != nil {
+		return x.CpuBreakdown
+	}
+	return nil
+}
+
+func (x *TraceTreeNode) GetChildren() []*TraceTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *TraceTreeNode) GetNotProfiledReason() string {
+	if x != nil {
+		return x.NotProfiledReason
+	}
+	return ""
+}
+
+func (x *TraceTreeNode) GetInstanceKey() string {
+	if x != nil {
+		return x.InstanceKey
+	}
+	return ""
+}
+
+type ErrorTreeNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceName       string           `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Url               string           `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	StartTime         uint64           `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TotalTime         uint64           `protobuf:"varint,5,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	P90               uint64           `protobuf:"varint,6,opt,name=p90,proto3" json:"p90,omitempty"`
+	ThresholdType     string           `protobuf:"bytes,7,opt,name=threshold_type,proto3" json:"threshold_type,omitempty"`
+	ThresholdValue    float64          `protobuf:"fixed64,8,opt,name=threshold_value,proto3" json:"threshold_value,omitempty"`
+	ThresholdRange    string           `protobuf:"bytes,9,opt,name=threshold_range,proto3" json:"threshold_range,omitempty"`
+	ThresholdMultiple float64          `protobuf:"fixed64,10,opt,name=threshold_multiple,proto3" json:"threshold_multiple,omitempty"`
+	IsSampled         bool             `protobuf:"varint,11,opt,name=is_sampled,json=isSampled,proto3" json:"is_sampled,omitempty"`
+	IsTraced          bool             `protobuf:"varint,12,opt,name=is_traced,json=isTraced,proto3" json:"is_traced,omitempty"`
+	IsProfiled        bool             `protobuf:"varint,13,opt,name=is_profiled,json=isProfiled,proto3" json:"is_profiled,omitempty"`
+	Pod               string           `protobuf:"bytes,14,opt,name=pod,proto3" json:"pod,omitempty"`
+	PodNs             string           `protobuf:"bytes,15,opt,name=pod_ns,json=podNS,proto3" json:"pod_ns,omitempty"`
+	Workload          string           `protobuf:"bytes,16,opt,name=workload,proto3" json:"workload,omitempty"`
+	WorkloadType      string           `protobuf:"bytes,17,opt,name=workload_type,json=workloadType,proto3" json:"workload_type,omitempty"`
+	IsError           bool             `protobuf:"varint,18,opt,name=is_error,json=isError,proto3" json:"is_error,omitempty"`
+	IsPath            bool             `protobuf:"varint,19,opt,name=is_path,json=isPath,proto3" json:"is_path,omitempty"`
+	IsMutated         bool             `protobuf:"varint,20,opt,name=is_mutated,json=isMutated,proto3" json:"is_mutated,omitempty"`
+	MissVNode         bool             `protobuf:"varint,21,opt,name=miss_v_node,json=missVNode,proto3" json:"miss_v_node,omitempty"`
+	SpanId            string           `protobuf:"bytes,22,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	Depth             int32            `protobuf:"varint,23,opt,name=depth,proto3" json:"depth,omitempty"`
+	NodeName          string           `protobuf:"bytes,24,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	Children          []*ErrorTreeNode `protobuf:"bytes,25,rep,name=children,proto3" json:"children,omitempty"`
+	ErrorSpans        []*ErrorSpan     `protobuf:"bytes,26,rep,name=error_spans,json=errorSpans,proto3" json:"error_spans,omitempty"`
+	Collapsed         *CollapsedNodes  `protobuf:"bytes,27,opt,name=collapsed,proto3" json:"collapsed,omitempty"`
+	NotProfiledReason string           `protobuf:"bytes,28,opt,name=not_profiled_reason,json=notProfiledReason,proto3" json:"not_profiled_reason,omitempty"`
+	InstanceKey       string           `protobuf:"bytes,29,opt,name=instance_key,json=instanceKey,proto3" json:"instance_key,omitempty"`
+}
+
+func (x *ErrorTreeNode) Reset() {
+	*x = ErrorTreeNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorTreeNode) ProtoMessage() {}
+
+func (x *ErrorTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorTreeNode.ProtoReflect.Descriptor instead.
+func (*ErrorTreeNode) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ErrorTreeNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ErrorTreeNode) GetTotalTime() uint64 {
+	if x != nil {
+		return x.TotalTime
+	}
+	return 0
+}
+
+func (x *ErrorTreeNode) GetP90() uint64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+func (x *ErrorTreeNode) GetThresholdType() string {
+	if x != nil {
+		return x.ThresholdType
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetThresholdValue() float64 {
+	if x != nil {
+		return x.ThresholdValue
+	}
+	return 0
+}
+
+func (x *ErrorTreeNode) GetThresholdRange() string {
+	if x != nil {
+		return x.ThresholdRange
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetThresholdMultiple() float64 {
+	if x != nil {
+		return x.ThresholdMultiple
+	}
+	return 0
+}
+
+func (x *ErrorTreeNode) GetIsSampled() bool {
+	if x != nil {
+		return x.IsSampled
+	}
+	return false
+}
+
+func (x *ErrorTreeNode) GetIsTraced() bool {
+	if x != nil {
+		return x.IsTraced
+	}
+	return false
+}
+
+func (x *ErrorTreeNode) GetIsProfiled() bool {
+	if x != nil {
+		return x.IsProfiled
+	}
+	return false
+}
+
+func (x *ErrorTreeNode) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetPodNs() string {
+	if x != nil {
+		return x.PodNs
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetWorkload() string {
+	if x != nil {
+		return x.Workload
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetWorkloadType() string {
+	if x != nil {
+		return x.WorkloadType
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetIsError() bool {
+	if x != nil {
+		return x.IsError
+	}
+	return false
+}
+
+func (x *ErrorTreeNode) GetIsPath() bool {
+	if x != nil {
+		return x.IsPath
+	}
+	return false
+}
+
+func (x *ErrorTreeNode) GetIsMutated() bool {
+	if x != nil {
+		return x.IsMutated
+	}
+	return false
+}
+
+func (x *ErrorTreeNode) GetMissVNode() bool {
+	if x != nil {
+		return x.MissVNode
+	}
+	return false
+}
+
+func (x *ErrorTreeNode) GetSpanId() string {
+	if x != nil {
+		return x.SpanId
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ErrorTreeNode) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetChildren() []*ErrorTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *ErrorTreeNode) GetErrorSpans() []*ErrorSpan {
+	if x != nil {
+		return x.ErrorSpans
+	}
+	return nil
+}
+
+func (x *ErrorTreeNode) GetCollapsed() *CollapsedNodes {
+	if x != nil {
+		return x.Collapsed
+	}
+	return nil
+}
+
+func (x *ErrorTreeNode) GetNotProfiledReason() string {
+	if x != nil {
+		return x.NotProfiledReason
+	}
+	return ""
+}
+
+func (x *ErrorTreeNode) GetInstanceKey() string {
+	if x != nil {
+		return x.InstanceKey
+	}
+	return ""
+}
+
+type ApmClientCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClientStartTime  uint64            `protobuf:"varint,1,opt,name=client_start_time,proto3" json:"client_start_time,omitempty"`
+	ClientEndTime    uint64            `protobuf:"varint,2,opt,name=client_end_time,proto3" json:"client_end_time,omitempty"`
+	ClientName       string            `protobuf:"bytes,3,opt,name=client_name,proto3" json:"client_name,omitempty"`
+	ClientSpanid     string            `protobuf:"bytes,4,opt,name=client_spanid,proto3" json:"client_spanid,omitempty"`
+	ClientAttributes map[string]string `protobuf:"bytes,5,rep,name=client_attributes,proto3" json:"client_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ServerDuration   uint64            `protobuf:"varint,6,opt,name=server_duration,proto3" json:"server_duration,omitempty"`
+	ServerName       string            `protobuf:"bytes,7,opt,name=server_name,proto3" json:"server_name,omitempty"`
+}
+
+func (x *ApmClientCall) Reset() {
+	*x = ApmClientCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApmClientCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApmClientCall) ProtoMessage() {}
+
+func (x *ApmClientCall) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApmClientCall.ProtoReflect.Descriptor instead.
+func (*ApmClientCall) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApmClientCall) GetClientStartTime() uint64 {
+	if x != nil {
+		return x.ClientStartTime
+	}
+	return 0
+}
+
+func (x *ApmClientCall) GetClientEndTime() uint64 {
+	if x != nil {
+		return x.ClientEndTime
+	}
+	return 0
+}
+
+func (x *ApmClientCall) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *ApmClientCall) GetClientSpanid() string {
+	if x != nil {
+		return x.ClientSpanid
+	}
+	return ""
+}
+
+func (x *ApmClientCall) GetClientAttributes() map[string]string {
+	if x != nil {
+		return x.ClientAttributes
+	}
+	return nil
+}
+
+func (x *ApmClientCall) GetServerDuration() uint64 {
+	if x != nil {
+		return x.ServerDuration
+	}
+	return 0
+}
+
+func (x *ApmClientCall) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+type ErrorSpan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartTime       uint64                     `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TotalTime       uint64                     `protobuf:"varint,3,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	Attributes      map[string]string          `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TypedAttributes map[string]*AttributeValue `protobuf:"bytes,5,rep,name=typed_attributes,json=typedAttributes,proto3" json:"typed_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Exceptions      []*Exception               `protobuf:"bytes,6,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+}
+
+func (x *ErrorSpan) Reset() {
+	*x = ErrorSpan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorSpan) ProtoMessage() {}
+
+func (x *ErrorSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorSpan.ProtoReflect.Descriptor instead.
+func (*ErrorSpan) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ErrorSpan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ErrorSpan) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ErrorSpan) GetTotalTime() uint64 {
+	if x != nil {
+		return x.TotalTime
+	}
+	return 0
+}
+
+func (x *ErrorSpan) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ErrorSpan) GetTypedAttributes() map[string]*AttributeValue {
+	if x != nil {
+		return x.TypedAttributes
+	}
+	return nil
+}
+
+func (x *ErrorSpan) GetExceptions() []*Exception {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+// AttributeValue is encoded as the raw value in JSON documents.
+type AttributeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*AttributeValue_StringValue
+	//	*AttributeValue_IntValue
+	//	*AttributeValue_BoolValue
+	//	*AttributeValue_DoubleValue
+	//	*AttributeValue_BytesValue
+	//	*AttributeValue_StringArrayValue
+	//	*AttributeValue_IntArrayValue
+	//	*AttributeValue_DoubleArrayValue
+	//	*AttributeValue_BoolArrayValue
+	Value isAttributeValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *AttributeValue) Reset() {
+	*x = AttributeValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValue) ProtoMessage() {}
+
+func (x *AttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValue.ProtoReflect.Descriptor instead.
+func (*AttributeValue) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *AttributeValue) GetValue() isAttributeValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *AttributeValue) GetStringValue() string {
+	if x, ok := x.GetValue().(*AttributeValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *AttributeValue) GetIntValue() int64 {
+	if x, ok := x.GetValue().(*AttributeValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *AttributeValue) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*AttributeValue_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *AttributeValue) GetDoubleValue() float64 {
+	if x, ok := x.GetValue().(*AttributeValue_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (x *AttributeValue) GetBytesValue() []byte {
+	if x, ok := x.GetValue().(*AttributeValue_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *AttributeValue) GetStringArrayValue() *AttributeValue_StringArray {
+	if x, ok := x.GetValue().(*AttributeValue_StringArrayValue); ok {
+		return x.StringArrayValue
+	}
+	return nil
+}
+
+func (x *AttributeValue) GetIntArrayValue() *AttributeValue_IntArray {
+	if x, ok := x.GetValue().(*AttributeValue_IntArrayValue); ok {
+		return x.IntArrayValue
+	}
+	return nil
+}
+
+func (x *AttributeValue) GetDoubleArrayValue() *AttributeValue_DoubleArray {
+	if x, ok := x.GetValue().(*AttributeValue_DoubleArrayValue); ok {
+		return x.DoubleArrayValue
+	}
+	return nil
+}
+
+func (x *AttributeValue) GetBoolArrayValue() *AttributeValue_BoolArray {
+	if x, ok := x.GetValue().(*AttributeValue_BoolArrayValue); ok {
+		return x.BoolArrayValue
+	}
+	return nil
+}
+
+type isAttributeValue_Value interface {
+	isAttributeValue_Value()
+}
+
+type AttributeValue_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type AttributeValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type AttributeValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,3,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type AttributeValue_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,4,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type AttributeValue_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,5,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type AttributeValue_StringArrayValue struct {
+	StringArrayValue *AttributeValue_StringArray `protobuf:"bytes,6,opt,name=string_array_value,json=stringArrayValue,proto3,oneof"`
+}
+
+type AttributeValue_IntArrayValue struct {
+	IntArrayValue *AttributeValue_IntArray `protobuf:"bytes,7,opt,name=int_array_value,json=intArrayValue,proto3,oneof"`
+}
+
+type AttributeValue_DoubleArrayValue struct {
+	DoubleArrayValue *AttributeValue_DoubleArray `protobuf:"bytes,8,opt,name=double_array_value,json=doubleArrayValue,proto3,oneof"`
+}
+
+type AttributeValue_BoolArrayValue struct {
+	BoolArrayValue *AttributeValue_BoolArray `protobuf:"bytes,9,opt,name=bool_array_value,json=boolArrayValue,proto3,oneof"`
+}
+
+func (*AttributeValue_StringValue) isAttributeValue_Value() {}
+
+func (*AttributeValue_IntValue) isAttributeValue_Value() {}
+
+func (*AttributeValue_BoolValue) isAttributeValue_Value() {}
+
+func (*AttributeValue_DoubleValue) isAttributeValue_Value() {}
+
+func (*AttributeValue_BytesValue) isAttributeValue_Value() {}
+
+func (*AttributeValue_StringArrayValue) isAttributeValue_Value() {}
+
+func (*AttributeValue_IntArrayValue) isAttributeValue_Value() {}
+
+func (*AttributeValue_DoubleArrayValue) isAttributeValue_Value() {}
+
+func (*AttributeValue_BoolArrayValue) isAttributeValue_Value() {}
+
+type Exception struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Stack     string `protobuf:"bytes,4,opt,name=stack,proto3" json:"stack,omitempty"`
+}
+
+func (x *Exception) Reset() {
+	*x = Exception{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Exception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Exception) ProtoMessage() {}
+
+func (x *Exception) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Exception.ProtoReflect.Descriptor instead.
+func (*Exception) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Exception) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Exception) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Exception) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Exception) GetStack() string {
+	if x != nil {
+		return x.Stack
+	}
+	return ""
+}
+
+type CallPattern struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ReqType    string   `protobuf:"bytes,2,opt,name=req_type,json=reqType,proto3" json:"req_type,omitempty"`
+	Target     string   `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Count      int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	ErrorCount int32    `protobuf:"varint,5,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	TotalTime  uint64   `protobuf:"varint,6,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"`
+	MaxTime    uint64   `protobuf:"varint,7,opt,name=max_time,json=maxTime,proto3" json:"max_time,omitempty"`
+	SavingTime uint64   `protobuf:"varint,8,opt,name=saving_time,json=savingTime,proto3" json:"saving_time,omitempty"`
+	Message    string   `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	SpanIds    []string `protobuf:"bytes,10,rep,name=span_ids,json=spanIds,proto3" json:"span_ids,omitempty"`
+}
+
+func (x *CallPattern) Reset() {
+	*x = CallPattern{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallPattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallPattern) ProtoMessage() {}
+
+func (x *CallPattern) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallPattern.ProtoReflect.Descriptor instead.
+func (*CallPattern) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CallPattern) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CallPattern) GetReqType() string {
+	if x != nil {
+		return x.ReqType
+	}
+	return ""
+}
+
+func (x *CallPattern) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CallPattern) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CallPattern) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *CallPattern) GetTotalTime() uint64 {
+	if x != nil {
+		return x.TotalTime
+	}
+	return 0
+}
+
+func (x *CallPattern) GetMaxTime() uint64 {
+	if x != nil {
+		return x.MaxTime
+	}
+	return 0
+}
+
+func (x *CallPattern) GetSavingTime() uint64 {
+	if x != nil {
+		return x.SavingTime
+	}
+	return 0
+}
+
+func (x *CallPattern) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CallPattern) GetSpanIds() []string {
+	if x != nil {
+		return x.SpanIds
+	}
+	return nil
+}
+
+type CollapsedNodes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count       int32  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MinTime     uint64 `protobuf:"varint,2,opt,name=min_time,json=minTime,proto3" json:"min_time,omitempty"`
+	MaxTime     uint64 `protobuf:"varint,3,opt,name=max_time,json=maxTime,proto3" json:"max_time,omitempty"`
+	AvgTime     uint64 `protobuf:"varint,4,opt,name=avg_time,json=avgTime,proto3" json:"avg_time,omitempty"`
+	SumTime     uint64 `protobuf:"varint,5,opt,name=sum_time,json=sumTime,proto3" json:"sum_time,omitempty"`
+	ElidedNodes int32  `protobuf:"varint,6,opt,name=elided_nodes,json=elidedNodes,proto3" json:"elided_nodes,omitempty"`
+}
+
+func (x *CollapsedNodes) Reset() {
+	*x = CollapsedNodes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollapsedNodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollapsedNodes) ProtoMessage() {}
+
+func (x *CollapsedNodes) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollapsedNodes.ProtoReflect.Descriptor instead.
+func (*CollapsedNodes) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CollapsedNodes) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CollapsedNodes) GetMinTime() uint64 {
+	if x != nil {
+		return x.MinTime
+	}
+	return 0
+}
+
+func (x *CollapsedNodes) GetMaxTime() uint64 {
+	if x != nil {
+		return x.MaxTime
+	}
+	return 0
+}
+
+func (x *CollapsedNodes) GetAvgTime() uint64 {
+	if x != nil {
+		return x.AvgTime
+	}
+	return 0
+}
+
+func (x *CollapsedNodes) GetSumTime() uint64 {
+	if x != nil {
+		return x.SumTime
+	}
+	return 0
+}
+
+func (x *CollapsedNodes) GetElidedNodes() int32 {
+	if x != nil {
+		return x.ElidedNodes
+	}
+	return 0
+}
+
+type CpuBreakdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tid          uint64            `protobuf:"varint,1,opt,name=tid,proto3" json:"tid,omitempty"`
+	ThreadName   string            `protobuf:"bytes,2,opt,name=thread_name,json=threadName,proto3" json:"thread_name,omitempty"`
+	StartTime    uint64            `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime      uint64            `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ObservedTime uint64            `protobuf:"varint,5,opt,name=observed_time,json=observedTime,proto3" json:"observed_time,omitempty"`
+	Times        map[string]uint64 `protobuf:"bytes,6,rep,name=times,proto3" json:"times,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Verdict      string            `protobuf:"bytes,7,opt,name=verdict,proto3" json:"verdict,omitempty"`
+	Message      string            `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CpuBreakdown) Reset() {
+	*x = CpuBreakdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpuBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpuBreakdown) ProtoMessage() {}
+
+func (x *CpuBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpuBreakdown.ProtoReflect.Descriptor instead.
+func (*CpuBreakdown) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CpuBreakdown) GetTid() uint64 {
+	if x != nil {
+		return x.Tid
+	}
+	return 0
+}
+
+func (x *CpuBreakdown) GetThreadName() string {
+	if x != nil {
+		return x.ThreadName
+	}
+	return ""
+}
+
+func (x *CpuBreakdown) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CpuBreakdown) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *CpuBreakdown) GetObservedTime() uint64 {
+	if x != nil {
+		return x.ObservedTime
+	}
+	return 0
+}
+
+func (x *CpuBreakdown) GetTimes() map[string]uint64 {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *CpuBreakdown) GetVerdict() string {
+	if x != nil {
+		return x.Verdict
+	}
+	return ""
+}
+
+func (x *CpuBreakdown) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GcCorrelation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pauses         []*GcPause `protobuf:"bytes,1,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	SelfTime       uint64     `protobuf:"varint,2,opt,name=self_time,json=selfTime,proto3" json:"self_time,omitempty"`
+	OverlapTime    uint64     `protobuf:"varint,3,opt,name=overlap_time,json=overlapTime,proto3" json:"overlap_time,omitempty"`
+	OverlapPercent float64    `protobuf:"fixed64,4,opt,name=overlap_percent,json=overlapPercent,proto3" json:"overlap_percent,omitempty"`
+	IsGcInduced    bool       `protobuf:"varint,5,opt,name=is_gc_induced,json=isGcInduced,proto3" json:"is_gc_induced,omitempty"`
+}
+
+func (x *GcCorrelation) Reset() {
+	*x = GcCorrelation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GcCorrelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GcCorrelation) ProtoMessage() {}
+
+func (x *GcCorrelation) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GcCorrelation.ProtoReflect.Descriptor instead.
+func (*GcCorrelation) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GcCorrelation) GetPauses() []*GcPause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+func (x *GcCorrelation) GetSelfTime() uint64 {
+	if x != nil {
+		return x.SelfTime
+	}
+	return 0
+}
+
+func (x *GcCorrelation) GetOverlapTime() uint64 {
+	if x != nil {
+		return x.OverlapTime
+	}
+	return 0
+}
+
+func (x *GcCorrelation) GetOverlapPercent() float64 {
+	if x != nil {
+		return x.OverlapPercent
+	}
+	return 0
+}
+
+func (x *GcCorrelation) GetIsGcInduced() bool {
+	if x != nil {
+		return x.IsGcInduced
+	}
+	return false
+}
+
+type GcPause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	StartTime   uint64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Duration    uint64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	OverlapTime uint64 `protobuf:"varint,4,opt,name=overlap_time,json=overlapTime,proto3" json:"overlap_time,omitempty"`
+}
+
+func (x *GcPause) Reset() {
+	*x = GcPause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GcPause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GcPause) ProtoMessage() {}
+
+func (x *GcPause) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GcPause.ProtoReflect.Descriptor instead.
+func (*GcPause) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GcPause) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GcPause) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GcPause) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *GcPause) GetOverlapTime() uint64 {
+	if x != nil {
+		return x.OverlapTime
+	}
+	return 0
+}
+
+type LockContentionSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime        uint64            `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime          uint64            `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	LockCount        int32             `protobuf:"varint,3,opt,name=lock_count,json=lockCount,proto3" json:"lock_count,omitempty"`
+	TotalBlockedTime uint64            `protobuf:"varint,4,opt,name=total_blocked_time,json=totalBlockedTime,proto3" json:"total_blocked_time,omitempty"`
+	BlockedPercent   float64           `protobuf:"fixed64,5,opt,name=blocked_percent,json=blockedPercent,proto3" json:"blocked_percent,omitempty"`
+	TopLocks         []*LockContention `protobuf:"bytes,6,rep,name=top_locks,json=topLocks,proto3" json:"top_locks,omitempty"`
+	ThreadTime       uint64            `protobuf:"varint,7,opt,name=thread_time,json=threadTime,proto3" json:"thread_time,omitempty"`
+}
+
+func (x *LockContentionSummary) Reset() {
+	*x = LockContentionSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockContentionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockContentionSummary) ProtoMessage() {}
+
+func (x *LockContentionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockContentionSummary.ProtoReflect.Descriptor instead.
+func (*LockContentionSummary) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LockContentionSummary) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *LockContentionSummary) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *LockContentionSummary) GetLockCount() int32 {
+	if x != nil {
+		return x.LockCount
+	}
+	return 0
+}
+
+func (x *LockContentionSummary) GetTotalBlockedTime() uint64 {
+	if x != nil {
+		return x.TotalBlockedTime
+	}
+	return 0
+}
+
+func (x *LockContentionSummary) GetBlockedPercent() float64 {
+	if x != nil {
+		return x.BlockedPercent
+	}
+	return 0
+}
+
+func (x *LockContentionSummary) GetTopLocks() []*LockContention {
+	if x != nil {
+		return x.TopLocks
+	}
+	return nil
+}
+
+func (x *LockContentionSummary) GetThreadTime() uint64 {
+	if x != nil {
+		return x.ThreadTime
+	}
+	return 0
+}
+
+type LockContention struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lock          string   `protobuf:"bytes,1,opt,name=lock,proto3" json:"lock,omitempty"`
+	WaitCount     int32    `protobuf:"varint,2,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	TotalWaitTime uint64   `protobuf:"varint,3,opt,name=total_wait_time,json=totalWaitTime,proto3" json:"total_wait_time,omitempty"`
+	MaxWaitTime   uint64   `protobuf:"varint,4,opt,name=max_wait_time,json=maxWaitTime,proto3" json:"max_wait_time,omitempty"`
+	Owners        []string `protobuf:"bytes,5,rep,name=owners,proto3" json:"owners,omitempty"`
+	Waiters       []string `protobuf:"bytes,6,rep,name=waiters,proto3" json:"waiters,omitempty"`
+}
+
+func (x *LockContention) Reset() {
+	*x = LockContention{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockContention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockContention) ProtoMessage() {}
+
+func (x *LockContention) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockContention.ProtoReflect.Descriptor instead.
+func (*LockContention) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LockContention) GetLock() string {
+	if x != nil {
+		return x.Lock
+	}
+	return ""
+}
+
+func (x *LockContention) GetWaitCount() int32 {
+	if x != nil {
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *LockContention) GetTotalWaitTime() uint64 {
+	if x != nil {
+		return x.TotalWaitTime
+	}
+	return 0
+}
+
+func (x *LockContention) GetMaxWaitTime() uint64 {
+	if x != nil {
+		return x.MaxWaitTime
+	}
+	return 0
+}
+
+func (x *LockContention) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *LockContention) GetWaiters() []string {
+	if x != nil {
+		return x.Waiters
+	}
+	return nil
+}
+
+type AttributeValue_StringArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *AttributeValue_StringArray) Reset() {
+	*x = AttributeValue_StringArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeValue_StringArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValue_StringArray) ProtoMessage() {}
+
+func (x *AttributeValue_StringArray) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValue_StringArray.ProtoReflect.Descriptor instead.
+func (*AttributeValue_StringArray) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *AttributeValue_StringArray) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type AttributeValue_IntArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []int64 `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *AttributeValue_IntArray) Reset() {
+	*x = AttributeValue_IntArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeValue_IntArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValue_IntArray) ProtoMessage() {}
+
+func (x *AttributeValue_IntArray) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValue_IntArray.ProtoReflect.Descriptor instead.
+func (*AttributeValue_IntArray) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *AttributeValue_IntArray) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type AttributeValue_DoubleArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []float64 `protobuf:"fixed64,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *AttributeValue_DoubleArray) Reset() {
+	*x = AttributeValue_DoubleArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeValue_DoubleArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValue_DoubleArray) ProtoMessage() {}
+
+func (x *AttributeValue_DoubleArray) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValue_DoubleArray.ProtoReflect.Descriptor instead.
+func (*AttributeValue_DoubleArray) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{8, 2}
+}
+
+func (x *AttributeValue_DoubleArray) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type AttributeValue_BoolArray struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []bool `protobuf:"varint,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *AttributeValue_BoolArray) Reset() {
+	*x = AttributeValue_BoolArray{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_report_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttributeValue_BoolArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValue_BoolArray) ProtoMessage() {}
+
+func (x *AttributeValue_BoolArray) ProtoReflect() protoreflect.Message {
+	mi := &file_report_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValue_BoolArray.ProtoReflect.Descriptor instead.
+func (*AttributeValue_BoolArray) Descriptor() ([]byte, []int) {
+	return file_report_proto_rawDescGZIP(), []int{8, 3}
+}
+
+func (x *AttributeValue_BoolArray) GetValues() []bool {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+var File_report_proto protoreflect.FileDescriptor
+
+var file_report_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d,
+	0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x22, 0xc9, 0x01,
+	0x0a, 0x10, 0x43, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6d, 0x65, 0x72, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xef, 0x09, 0x0a, 0x14, 0x43, 0x61,
+	0x6d, 0x65, 0x72, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x75, 0x74, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x75,
+	0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x75, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x75,
+	0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x15,
+	0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6d, 0x75, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x15, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65,
+	0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72,
+	0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x72, 0x65, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x11, 0x6f, 0x74, 0x65, 0x6c, 0x5f, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x11, 0x6f, 0x74, 0x65, 0x6c, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x6c,
+	0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6c, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x6c, 0x69, 0x64, 0x65, 0x64,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x63, 0x70, 0x75, 0x5f, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x70,
+	0x75, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x0d, 0x63, 0x70, 0x75, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6c, 0x6f,
+	0x77, 0x5f, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x76, 0x65, 0x72, 0x64, 0x69, 0x63, 0x74, 0x12, 0x44, 0x0a,
+	0x0e, 0x67, 0x63, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x63, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x67, 0x63, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4e, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61,
+	0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x2e, 0x0a, 0x12, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12,
+	0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x12,
+	0x32, 0x0a, 0x14, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6d,
+	0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61,
+	0x6c, 0x6c, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0xed, 0x01, 0x0a, 0x0b,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xff, 0x06, 0x0a, 0x0f,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x75, 0x74, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f,
+	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64,
+	0x5f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x75, 0x74, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x6d, 0x75, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x34, 0x0a, 0x15, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x72, 0x65, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70,
+	0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6c, 0x69,
+	0x64, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x65, 0x6c, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x75, 0x74,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xd3, 0x08,
+	0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39, 0x30, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x70, 0x39, 0x30, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x39, 0x30, 0x5f, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x39, 0x30, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x2e, 0x0a, 0x12, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x54, 0x72, 0x61, 0x63, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x64,
+	0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x6f, 0x64, 0x4e, 0x53, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x77, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x76, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x69, 0x73, 0x73, 0x56, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x70, 0x39, 0x30, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x73, 0x65, 0x6c, 0x66, 0x50, 0x39, 0x30, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x75, 0x74, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6c, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x6f,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x70, 0x75, 0x5f, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x70,
+	0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x70, 0x75, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72,
+	0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e,
+	0x6f, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x4b, 0x65, 0x79, 0x22, 0xdb, 0x07, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x72, 0x65,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x39, 0x30, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x70, 0x39, 0x30, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x12, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x70, 0x6f, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x6e, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x4e, 0x53, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x73, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x76, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x69, 0x73, 0x73, 0x56, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x73, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x70,
+	0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x53, 0x70, 0x61, 0x6e,
+	0x73, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x6e, 0x6f, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x6f, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x65,
+	0x79, 0x22, 0xa2, 0x03, 0x0a, 0x0d, 0x41, 0x70, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x61, 0x6c, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x61,
+	0x6e, 0x69, 0x64, 0x12, 0x60, 0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x6d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x1a, 0x43, 0x0a, 0x15, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdd, 0x03, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x53, 0x70, 0x61, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x6f,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x53, 0x70, 0x61, 0x6e, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x58, 0x0a, 0x10, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x70, 0x6f,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x53, 0x70, 0x61, 0x6e, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x74, 0x79, 0x70, 0x65, 0x64,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x78,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x61, 0x0a, 0x14, 0x54, 0x79, 0x70, 0x65, 0x64, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61,
+	0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xba, 0x05, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23,
+	0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52,
+	0x10, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x50, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x6f,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x59, 0x0a, 0x12, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x72,
+	0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x48, 0x00, 0x52, 0x10, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x53,
+	0x0a, 0x10, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x41, 0x72, 0x72, 0x61,
+	0x79, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x6f, 0x6f, 0x6c, 0x41, 0x72, 0x72, 0x61, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x25, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x22, 0x0a, 0x08, 0x49, 0x6e,
+	0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x25,
+	0x0a, 0x0b, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x23, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x41, 0x72, 0x72,
+	0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x08, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x6d, 0x0a, 0x09, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x22, 0x9b, 0x02, 0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61,
+	0x76, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x70, 0x61, 0x6e, 0x49, 0x64, 0x73,
+	0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x76, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x61, 0x76, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75,
+	0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x75,
+	0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x69, 0x64, 0x65, 0x64, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x6c, 0x69,
+	0x64, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xcc, 0x02, 0x0a, 0x0c, 0x43, 0x70, 0x75,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x74, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x70, 0x6f,
+	0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x70, 0x75, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x64, 0x69, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x64,
+	0x69, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x38, 0x0a,
+	0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcc, 0x01, 0x0a, 0x0d, 0x47, 0x63, 0x43, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x6f, 0x2e,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x63, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6c,
+	0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x6c, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61,
+	0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x76,
+	0x65, 0x72, 0x6c, 0x61, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65,
+	0x72, 0x6c, 0x61, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x67, 0x63, 0x5f, 0x69, 0x6e, 0x64, 0x75,
+	0x63, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x47, 0x63, 0x49,
+	0x6e, 0x64, 0x75, 0x63, 0x65, 0x64, 0x22, 0x7b, 0x0a, 0x07, 0x47, 0x63, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xa4, 0x02, 0x0a, 0x15, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x0a,
+	0x09, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x70, 0x6f, 0x2e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x0e, 0x4c,
+	0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x69, 0x74, 0x65, 0x72, 0x73, 0x4a, 0x04,
+	0x08, 0x07, 0x10, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x42, 0x35, 0x5a, 0x33, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x2f, 0x61, 0x70, 0x6f, 0x2d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_report_proto_rawDescOnce sync.Once
+	file_report_proto_rawDescData = file_report_proto_rawDesc
+)
+
+func file_report_proto_rawDescGZIP() []byte {
+	file_report_proto_rawDescOnce.Do(func() {
+		file_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_report_proto_rawDescData)
+	})
+	return file_report_proto_rawDescData
+}
+
+var file_report_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_report_proto_goTypes = []interface{}{
+	(*CameraNodeReport)(nil),           // 0: apo.report.v1.CameraNodeReport
+	(*CameraNodeReportData)(nil),       // 1: apo.report.v1.CameraNodeReportData
+	(*ErrorReport)(nil),                // 2: apo.report.v1.ErrorReport
+	(*ErrorReportData)(nil),            // 3: apo.report.v1.ErrorReportData
+	(*TraceTreeNode)(nil),              // 4: apo.report.v1.TraceTreeNode
+	(*ErrorTreeNode)(nil),              // 5: apo.report.v1.ErrorTreeNode
+	(*ApmClientCall)(nil),              // 6: apo.report.v1.ApmClientCall
+	(*ErrorSpan)(nil),                  // 7: apo.report.v1.ErrorSpan
+	(*AttributeValue)(nil),             // 8: apo.report.v1.AttributeValue
+	(*Exception)(nil),                  // 9: apo.report.v1.Exception
+	(*CallPattern)(nil),                // 10: apo.report.v1.CallPattern
+	(*CollapsedNodes)(nil),             // 11: apo.report.v1.CollapsedNodes
+	(*CpuBreakdown)(nil),               // 12: apo.report.v1.CpuBreakdown
+	(*GcCorrelation)(nil),              // 13: apo.report.v1.GcCorrelation
+	(*GcPause)(nil),                    // 14: apo.report.v1.GcPause
+	(*LockContentionSummary)(nil),      // 15: apo.report.v1.LockContentionSummary
+	(*LockContention)(nil),             // 16: apo.report.v1.LockContention
+	nil,                                // 17: apo.report.v1.ApmClientCall.ClientAttributesEntry
+	nil,                                // 18: apo.report.v1.ErrorSpan.AttributesEntry
+	nil,                                // 19: apo.report.v1.ErrorSpan.TypedAttributesEntry
+	(*AttributeValue_StringArray)(nil), // 20: apo.report.v1.AttributeValue.StringArray
+	(*AttributeValue_IntArray)(nil),    // 21: apo.report.v1.AttributeValue.IntArray
+	(*AttributeValue_DoubleArray)(nil), // 22: apo.report.v1.AttributeValue.DoubleArray
+	(*AttributeValue_BoolArray)(nil),   // 23: apo.report.v1.AttributeValue.BoolArray
+	nil,                                // 24: apo.report.v1.CpuBreakdown.TimesEntry
+}
+var file_report_proto_depIdxs = []int32{
+	1,  // 0: apo.report.v1.CameraNodeReport.data:type_name -> apo.report.v1.CameraNodeReportData
+	4,  // 1: apo.report.v1.CameraNodeReportData.relation_trees:type_name -> apo.report.v1.TraceTreeNode
+	6,  // 2: apo.report.v1.CameraNodeReportData.otel_client_calls:type_name -> apo.report.v1.ApmClientCall
+	12, // 3: apo.report.v1.CameraNodeReportData.cpu_breakdown:type_name -> apo.report.v1.CpuBreakdown
+	13, // 4: apo.report.v1.CameraNodeReportData.gc_correlation:type_name -> apo.report.v1.GcCorrelation
+	15, // 5: apo.report.v1.CameraNodeReportData.lock_contention:type_name -> apo.report.v1.LockContentionSummary
+	3,  // 6: apo.report.v1.ErrorReport.data:type_name -> apo.report.v1.ErrorReportData
+	5,  // 7: apo.report.v1.ErrorReportData.relation_trees:type_name -> apo.report.v1.ErrorTreeNode
+	10, // 8: apo.report.v1.TraceTreeNode.call_patterns:type_name -> apo.report.v1.CallPattern
+	11, // 9: apo.report.v1.TraceTreeNode.collapsed:type_name -> apo.report.v1.CollapsedNodes
+	12, // 10: apo.report.v1.TraceTreeNode.cpu_breakdown:type_name -> apo.report.v1.CpuBreakdown
+	4,  // 11: apo.report.v1.TraceTreeNode.children:type_name -> apo.report.v1.TraceTreeNode
+	5,  // 12: apo.report.v1.ErrorTreeNode.children:type_name -> apo.report.v1.ErrorTreeNode
+	7,  // 13: apo.report.v1.ErrorTreeNode.error_spans:type_name -> apo.report.v1.ErrorSpan
+	11, // 14: apo.report.v1.ErrorTreeNode.collapsed:type_name -> apo.report.v1.CollapsedNodes
+	17, // 15: apo.report.v1.ApmClientCall.client_attributes:type_name -> apo.report.v1.ApmClientCall.ClientAttributesEntry
+	18, // 16: apo.report.v1.ErrorSpan.attributes:type_name -> apo.report.v1.ErrorSpan.AttributesEntry
+	19, // 17: apo.report.v1.ErrorSpan.typed_attributes:type_name -> apo.report.v1.ErrorSpan.TypedAttributesEntry
+	9,  // 18: apo.report.v1.ErrorSpan.exceptions:type_name -> apo.report.v1.Exception
+	20, // 19: apo.report.v1.AttributeValue.string_array_value:type_name -> apo.report.v1.AttributeValue.StringArray
+	21, // 20: apo.report.v1.AttributeValue.int_array_value:type_name -> apo.report.v1.AttributeValue.IntArray
+	22, // 21: apo.report.v1.AttributeValue.double_array_value:type_name -> apo.report.v1.AttributeValue.DoubleArray
+	23, // 22: apo.report.v1.AttributeValue.bool_array_value:type_name -> apo.report.v1.AttributeValue.BoolArray
+	24, // 23: apo.report.v1.CpuBreakdown.times:type_name -> apo.report.v1.CpuBreakdown.TimesEntry
+	14, // 24: apo.report.v1.GcCorrelation.pauses:type_name -> apo.report.v1.GcPause
+	16, // 25: apo.report.v1.LockContentionSummary.top_locks:type_name -> apo.report.v1.LockContention
+	8,  // 26: apo.report.v1.ErrorSpan.TypedAttributesEntry.value:type_name -> apo.report.v1.AttributeValue
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
+}
+
+func init() { file_report_proto_init() }
+func file_report_proto_init() {
+	if File_report_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_report_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CameraNodeReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CameraNodeReportData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorReportData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceTreeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorTreeNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApmClientCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorSpan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Exception); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallPattern); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollapsedNodes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpuBreakdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GcCorrelation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GcPause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockContentionSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockContention); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeValue_StringArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeValue_IntArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeValue_DoubleArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_report_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeValue_BoolArray); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_report_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*AttributeValue_StringValue)(nil),
+		(*AttributeValue_IntValue)(nil),
+		(*AttributeValue_BoolValue)(nil),
+		(*AttributeValue_DoubleValue)(nil),
+		(*AttributeValue_BytesValue)(nil),
+		(*AttributeValue_StringArrayValue)(nil),
+		(*AttributeValue_IntArrayValue)(nil),
+		(*AttributeValue_DoubleArrayValue)(nil),
+		(*AttributeValue_BoolArrayValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_report_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_report_proto_goTypes,
+		DependencyIndexes: file_report_proto_depIdxs,
+		MessageInfos:      file_report_proto_msgTypes,
+	}.Build()
+	File_report_proto = out.File
+	file_report_proto_rawDesc = nil
+	file_report_proto_goTypes = nil
+	file_report_proto_depIdxs = nil
+}
@@ -0,0 +1,138 @@
+package reportpb
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/CloudDetail/apo-module/model/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var update = flag.Bool("update", false, "update the json schema files")
+
+func TestCameraNodeReportRoundTrip(t *testing.T) {
+	mutated := &model.TraceTreeNode{
+		Id: "order-pod", InstanceKey: "pod:default/order-pod", ServiceName: "order", Url: "/order", StartTime: 10, TotalTime: 80, P90: 20, P90Source: model.P90FromPQL,
+		IsTraced: true, IsPath: true, IsMutated: true, SelfTime: 60, SelfP90: 10, MutatedValue: 50, SpanId: "s2",
+		CallPatterns: []*model.CallPattern{{Type: model.NPlusOneCallPattern, Target: "SELECT ?", Count: 10, SpanIds: []string{"c1"}}},
+		Collapsed:    &model.CollapsedNodes{Count: 2, MinTime: 1, MaxTime: 3, AvgTime: 2, SumTime: 4, ElidedNodes: 2},
+		CpuBreakdown: &model.CpuBreakdown{Tid: 1, Times: map[string]uint64{"cpu": 60}, Verdict: model.VerdictCPUBound},
+		Children:     []*model.TraceTreeNode{},
+	}
+	root := &model.TraceTreeNode{Id: "entry-pod", ServiceName: "entry", TotalTime: 100, IsPath: true, MutatedValue: -5, NotProfiledReason: "agent is not attached", Children: []*model.TraceTreeNode{}}
+	root.AddChild(mutated)
+
+	report := &model.CameraNodeReport{
+		SchemaVersion: model.ReportSchemaVersion,
+		Timestamp:     1,
+		TraceId:       "t1",
+		Duration:      100,
+		Data: model.CameraNodeReportData{
+			EntryService:       "entry",
+			MutatedService:     "order",
+			MutatedInstanceKey: "pod:default/order-pod",
+			MutatedSpan:        "s2",
+			MutatedPodNS:       "default",
+			RelationTree:       root,
+			OTelClientCalls:    []*model.ApmClientCall{{ClientName: "SELECT", ClientSpanId: "c1", ClientAttributes: map[string]string{"db.system": "mysql"}}},
+			ElidedNodes:        2,
+			CpuBreakdown:       mutated.CpuBreakdown,
+			SlowVerdict:        model.VerdictGcInduced,
+			GcCorrelation:      &model.GcCorrelation{Pauses: []*model.GcPause{{Type: "ygc", StartTime: 20, Duration: 40}}, OverlapPercent: 66.6, IsGcInduced: true},
+			LockContention: &model.LockContentionSummary{LockCount: 1, TopLocks: []*model.LockContention{
+				{Lock: "0x1", WaitCount: 1, Waiters: []string{"t-2"}},
+			}},
+			ThresholdType:     model.P90ThresholdType,
+			ThresholdValue:    50,
+			ThresholdRange:    model.RangeLast1h,
+			ThresholdMultiple: 1,
+		},
+	}
+
+	data, err := proto.Marshal(FromCameraNodeReport(report))
+	if err != nil {
+		t.Fatalf("proto.Marshal() error = %v", err)
+	}
+	pbReport := &CameraNodeReport{}
+	if err := proto.Unmarshal(data, pbReport); err != nil {
+		t.Fatalf("proto.Unmarshal() error = %v", err)
+	}
+	got := ToCameraNodeReport(pbReport)
+	assertSameJSON(t, got, report)
+	if mutatedNode := got.Data.RelationTree.GetMutatedNode(); mutatedNode == nil || mutatedNode.Parent != got.Data.RelationTree {
+		t.Errorf("mutated node is not linked to root")
+	}
+}
+
+func TestErrorReportRoundTrip(t *testing.T) {
+	errorSpan := model.NewErrorSpan("GET /order", 10, 20)
+	typedAttributes := model.NewAttributeMap()
+	typedAttributes.AddIntValue("http.status_code", 500)
+	typedAttributes.AddDoubleValue("ratio", 0.5)
+	typedAttributes.AddStringArrayValue("tags", []string{"a", "b"})
+	errorSpan.SetTypedAttributes(typedAttributes)
+	errorSpan.Exceptions = append(errorSpan.Exceptions, model.NewOtelException(15, "java.io.IOException", "broken pipe", "at a"))
+
+	root := &model.ErrorTreeNode{ServiceName: "entry", IsError: true, IsPath: true, Children: []*model.ErrorTreeNode{}, ErrorSpans: []*model.ErrorSpan{}}
+	root.AddChild(&model.ErrorTreeNode{ServiceName: "order", IsError: true, IsMutated: true, Depth: 1, NodeName: "node-1",
+		Children: []*model.ErrorTreeNode{}, ErrorSpans: []*model.ErrorSpan{errorSpan}})
+	report := &model.ErrorReport{
+		SchemaVersion: model.ReportSchemaVersion,
+		Name:          "error",
+		TraceId:       "t1",
+		IsDrop:        true,
+		Data:          &model.ErrorReportData{MutatedService: "order", Cause: "java.io.IOException", RelationTree: root},
+	}
+
+	data, err := proto.Marshal(FromErrorReport(report))
+	if err != nil {
+		t.Fatalf("proto.Marshal() error = %v", err)
+	}
+	pbReport := &ErrorReport{}
+	if err := proto.Unmarshal(data, pbReport); err != nil {
+		t.Fatalf("proto.Unmarshal() error = %v", err)
+	}
+	assertSameJSON(t, ToErrorReport(pbReport), report)
+}
+
+func assertSameJSON(t *testing.T, got interface{}, want interface{}) {
+	t.Helper()
+	gotJson, _ := json.Marshal(got)
+	wantJson, _ := json.Marshal(want)
+	if string(gotJson) != string(wantJson) {
+		t.Errorf("got  %s\nwant %s", gotJson, wantJson)
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	tests := []struct {
+		file   string
+		schema func() ([]byte, error)
+	}{
+		{"camera_node_report.schema.json", CameraNodeReportJSONSchema},
+		{"error_report.schema.json", ErrorReportJSONSchema},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			schema, err := tt.schema()
+			if err != nil {
+				t.Fatalf("generate %s error = %v", tt.file, err)
+			}
+			schema = append(schema, '\n')
+			if *update {
+				if err := os.WriteFile(tt.file, schema, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(tt.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(schema) != string(want) {
+				t.Errorf("%s is out of date with report.proto, run go test -run TestJSONSchema -update", tt.file)
+			}
+		})
+	}
+}
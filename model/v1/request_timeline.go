@@ -0,0 +1,155 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ThreadEvents is the events of one thread, TransactionIds contains the events of all traces handled by the thread.
+type ThreadEvents struct {
+	Tid            uint64
+	ThreadName     string
+	TransactionIds []*TransactionIdEvent
+	CpuEvents      []*CpuEvent
+}
+
+// ThreadSegment is the time a thread worked on the request.
+type ThreadSegment struct {
+	Tid        uint64         `json:"tid"`
+	ThreadName string         `json:"threadName"`
+	StartTime  uint64         `json:"startTime"`
+	EndTime    uint64         `json:"endTime"`
+	AggTime    AggregatedTime `json:"aggTime"`
+	Profiles   *Profiles      `json:"-"`
+}
+
+// HandOffGap is the time no thread worked on the request, eg. the task is queued in executor.
+type HandOffGap struct {
+	FromTid   uint64 `json:"fromTid"`
+	ToTid     uint64 `json:"toTid"`
+	StartTime uint64 `json:"startTime"`
+	EndTime   uint64 `json:"endTime"`
+	Duration  uint64 `json:"duration"`
+}
+
+type RequestTimeline struct {
+	TraceId     string           `json:"traceId"`
+	StartTime   uint64           `json:"startTime"`
+	EndTime     uint64           `json:"endTime"`
+	ThreadCount int              `json:"threadCount"`
+	Segments    []*ThreadSegment `json:"segments"`
+	Gaps        []*HandOffGap    `json:"gaps"`
+	// Aggregated time of all segments.
+	AggTime AggregatedTime `json:"aggTime"`
+}
+
+// BuildRequestTimeline stitches the segments of all threads which worked on traceId into one timeline.
+// A segment starts at the entry TransactionIdEvent of traceId and ends at the next TransactionIdEvent of the thread,
+// segment without end is closed by the last cpu event of thread.
+func BuildRequestTimeline(traceId string, threads []*ThreadEvents) *RequestTimeline {
+	timeline := &RequestTimeline{
+		TraceId:  traceId,
+		Segments: make([]*ThreadSegment, 0),
+		Gaps:     make([]*HandOffGap, 0),
+	}
+	threadIds := make(map[uint64]bool)
+	for _, thread := range threads {
+		cpuEventIndex := NewCpuEventIndex(thread.CpuEvents)
+		for _, segment := range getThreadSegments(traceId, thread) {
+			profiles := cpuEventIndex.NewProfiles(segment.StartTime, segment.EndTime)
+			profiles.Tid = thread.Tid
+			profiles.ThreadName = thread.ThreadName
+			segment.AggTime = profiles.AggTime
+			segment.Profiles = profiles
+			for i, value := range segment.AggTime.Times {
+				timeline.AggTime.Times[i] += value
+			}
+			timeline.Segments = append(timeline.Segments, segment)
+			threadIds[thread.Tid] = true
+		}
+	}
+	timeline.ThreadCount = len(threadIds)
+	if len(timeline.Segments) == 0 {
+		return timeline
+	}
+
+	sort.SliceStable(timeline.Segments, func(i, j int) bool {
+		return timeline.Segments[i].StartTime < timeline.Segments[j].StartTime
+	})
+	timeline.StartTime = timeline.Segments[0].StartTime
+	last := timeline.Segments[0]
+	for _, segment := range timeline.Segments[1:] {
+		if segment.StartTime > last.EndTime {
+			timeline.Gaps = append(timeline.Gaps, &HandOffGap{
+				FromTid:   last.Tid,
+				ToTid:     segment.Tid,
+				StartTime: last.EndTime,
+				EndTime:   segment.StartTime,
+				Duration:  segment.StartTime - last.EndTime,
+			})
+		}
+		if segment.EndTime > last.EndTime {
+			last = segment
+		}
+	}
+	timeline.EndTime = last.EndTime
+	return timeline
+}
+
+func getThreadSegments(traceId string, thread *ThreadEvents) []*ThreadSegment {
+	events := make([]*TransactionIdEvent, len(thread.TransactionIds))
+	copy(events, thread.TransactionIds)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp < events[j].Timestamp
+	})
+
+	segments := make([]*ThreadSegment, 0)
+	var current *ThreadSegment
+	for _, event := range events {
+		if current != nil {
+			// Thread leaves the request or switches to another one.
+			current.EndTime = event.Timestamp
+			segments = append(segments, current)
+			current = nil
+		}
+		if event.TraceId == traceId && event.IsEntry == 1 {
+			current = &ThreadSegment{Tid: thread.Tid, ThreadName: thread.ThreadName, StartTime: event.Timestamp}
+		}
+	}
+	if current != nil {
+		for _, cpuEvent := range thread.CpuEvents {
+			if cpuEvent.EndTime > current.EndTime {
+				current.EndTime = cpuEvent.EndTime
+			}
+		}
+		if current.EndTime > current.StartTime {
+			segments = append(segments, current)
+		}
+	}
+	return segments
+}
+
+// GetProfiles returns the profiles of each segment, which can be exported by WritePprof of model/pprof.
+func (timeline *RequestTimeline) GetProfiles() []*Profiles {
+	profilesList := make([]*Profiles, 0, len(timeline.Segments))
+	for _, segment := range timeline.Segments {
+		profilesList = append(profilesList, segment.Profiles)
+	}
+	return profilesList
+}
+
+func (timeline *RequestTimeline) ToString() string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("TraceId: %s, StartTime: %d, EndTime: %d, Threads: %d, Segments: [\n",
+		timeline.TraceId, timeline.StartTime, timeline.EndTime, timeline.ThreadCount))
+	for _, segment := range timeline.Segments {
+		text.WriteString(fmt.Sprintf("    {%s-%d [%d, %d] %s}\n", segment.ThreadName, segment.Tid, segment.StartTime, segment.EndTime, structToString(segment.AggTime)))
+	}
+	text.WriteString("], Gaps: [\n")
+	for _, gap := range timeline.Gaps {
+		text.WriteString(fmt.Sprintf("    {%d -> %d [%d, %d] %d}\n", gap.FromTid, gap.ToTid, gap.StartTime, gap.EndTime, gap.Duration))
+	}
+	text.WriteString("]")
+	return text.String()
+}
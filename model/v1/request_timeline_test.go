@@ -0,0 +1,35 @@
+package model
+
+import "testing"
+
+func TestBuildRequestTimeline(t *testing.T) {
+	threads := []*ThreadEvents{
+		{
+			Tid: 1, ThreadName: "http",
+			TransactionIds: []*TransactionIdEvent{
+				{Timestamp: 100, TraceId: "t1", IsEntry: 1},
+				{Timestamp: 200, TraceId: "t1", IsEntry: 0},
+				{Timestamp: 250, TraceId: "t2", IsEntry: 1},
+			},
+			CpuEvents: []*CpuEvent{{StartTime: 100, EndTime: 200, TypeSpecs: []uint64{100}, TimeType: []CPUType{CPUType_ON}}},
+		},
+		{
+			Tid: 2, ThreadName: "pool",
+			TransactionIds: []*TransactionIdEvent{
+				{Timestamp: 300, TraceId: "t1", IsEntry: 1},
+			},
+			CpuEvents: []*CpuEvent{{StartTime: 300, EndTime: 400, TypeSpecs: []uint64{100}, TimeType: []CPUType{CPUType_NET}, RunqLatency: []uint64{0}}},
+		},
+	}
+
+	timeline := BuildRequestTimeline("t1", threads)
+	if len(timeline.Segments) != 2 || timeline.ThreadCount != 2 || timeline.StartTime != 100 || timeline.EndTime != 400 {
+		t.Fatalf("BuildRequestTimeline() = %s", timeline.ToString())
+	}
+	if len(timeline.Gaps) != 1 || timeline.Gaps[0].FromTid != 1 || timeline.Gaps[0].ToTid != 2 || timeline.Gaps[0].Duration != 100 {
+		t.Errorf("Gaps = %s", timeline.ToString())
+	}
+	if timeline.AggTime.Times[CPUType_ON] != 100 || timeline.AggTime.Times[CPUType_NET] != 100 {
+		t.Errorf("AggTime = %v", timeline.AggTime.Times)
+	}
+}
@@ -1,31 +1,50 @@
 package model
 
-import "time"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
 
 type ThresholdType string
 
 const (
-	P90ThresholdType ThresholdType = "LatencyP90"
-	P95ThresholdType ThresholdType = "LatencyP95"
-	P99ThresholdType ThresholdType = "LatencyP99"
+	AvgThresholdType      ThresholdType = "LatencyAvg"
+	P50ThresholdType      ThresholdType = "LatencyP50"
+	P90ThresholdType      ThresholdType = "LatencyP90"
+	P95ThresholdType      ThresholdType = "LatencyP95"
+	P99ThresholdType      ThresholdType = "LatencyP99"
+	P999ThresholdType     ThresholdType = "LatencyP999"
+	AbsoluteThresholdType ThresholdType = "LatencyAbsolute" // constant value in ms
 )
 
 type ThresholdRange string
 
 const (
-	RangeLast1h    ThresholdRange = "last1h"
-	RangeYesterday ThresholdRange = "yesterday"
-	RangeConstant  ThresholdRange = "constant"
+	RangeLast1h           ThresholdRange = "last1h"
+	RangeYesterday        ThresholdRange = "yesterday"
+	RangeConstant         ThresholdRange = "constant"
+	RangeSameHourLastWeek ThresholdRange = "sameHourLastWeek"
+	RangeLast7d           ThresholdRange = "last7d"
 )
 
+// NewLastRange returns a custom range like last30m, last6h or last3d.
+func NewLastRange(duration time.Duration) ThresholdRange {
+	return ThresholdRange("last" + FormatPromDuration(duration))
+}
+
 func (t ThresholdType) GetPercentile() float64 {
 	switch t {
+	case P50ThresholdType:
+		return 0.5
 	case P90ThresholdType:
 		return 0.9
 	case P95ThresholdType:
 		return 0.95
 	case P99ThresholdType:
 		return 0.99
+	case P999ThresholdType:
+		return 0.999
 	default:
 		return 0
 	}
@@ -33,40 +52,154 @@ func (t ThresholdType) GetPercentile() float64 {
 
 func (t ThresholdType) GetPercentileString() string {
 	switch t {
+	case P50ThresholdType:
+		return "0.5"
 	case P90ThresholdType:
 		return "0.9"
 	case P95ThresholdType:
 		return "0.95"
 	case P99ThresholdType:
 		return "0.99"
+	case P999ThresholdType:
+		return "0.999"
 	default:
 		return "0"
 	}
 }
 
+func (t ThresholdType) IsPercentile() bool {
+	return t.GetPercentile() > 0
+}
+
+func (t ThresholdType) IsAverage() bool {
+	return t == AvgThresholdType
+}
+
+func (t ThresholdType) IsAbsolute() bool {
+	return t == AbsoluteThresholdType
+}
+
+// GetThreshold returns the threshold in ns, value of absolute threshold is in ms.
+func (t ThresholdType) GetThreshold(value float64) uint64 {
+	if t.IsAbsolute() {
+		return uint64(value * 1e6)
+	}
+	return uint64(value)
+}
+
+// GetBaseline returns the baseline latency in ns which is the threshold divided by multiple,
+// absolute threshold has no baseline so the threshold is used.
+func (t ThresholdType) GetBaseline(value float64, multiple float64) uint64 {
+	if t.IsAbsolute() {
+		return t.GetThreshold(value)
+	}
+	if multiple <= 0 {
+		return 0
+	}
+	return uint64(value / multiple)
+}
+
+// GetRange returns the end of baseline window as query time and the window size as PromQL duration.
 func (r ThresholdRange) GetRange(traceStartTSNano int64) (startTSMill int64, duration string) {
+	return r.GetRangeInLocation(traceStartTSNano, time.Local)
+}
+
+// GetRangeInLocation is same as GetRange, day and hour boundaries are aligned in loc.
+func (r ThresholdRange) GetRangeInLocation(traceStartTSNano int64, loc *time.Location) (startTSMill int64, duration string) {
+	start, end := r.GetWindow(traceStartTSNano, loc)
+	return end.UnixMilli(), FormatPromDuration(end.Sub(start))
+}
+
+// GetWindow returns the baseline window [start, end) for a trace started at traceStartTSNano.
+func (r ThresholdRange) GetWindow(traceStartTSNano int64, loc *time.Location) (start time.Time, end time.Time) {
+	if loc == nil {
+		loc = time.Local
+	}
+	traceStart := time.UnixMilli(traceStartTSNano / 1e6).In(loc)
 	switch r {
-	case RangeLast1h:
-		return traceStartTSNano / 1e6, "1h"
 	case RangeYesterday:
-		startTime := time.UnixMilli(traceStartTSNano / 1e6)
-		return time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 0, 0, 0, 0, startTime.Location()).UnixMilli(), "24h"
-	case RangeConstant:
-		return traceStartTSNano / 1e6, "1h"
+		today := time.Date(traceStart.Year(), traceStart.Month(), traceStart.Day(), 0, 0, 0, 0, loc)
+		return today.AddDate(0, 0, -1), today
+	case RangeSameHourLastWeek:
+		// AddDate keeps the wall clock, so the same hour is used when DST changes during the week.
+		hour := time.Date(traceStart.Year(), traceStart.Month(), traceStart.Day(), traceStart.Hour(), 0, 0, 0, loc)
+		lastWeekHour := hour.AddDate(0, 0, -7)
+		return lastWeekHour, lastWeekHour.Add(time.Hour)
+	case RangeLast7d:
+		today := time.Date(traceStart.Year(), traceStart.Month(), traceStart.Day(), 0, 0, 0, 0, loc)
+		return today.AddDate(0, 0, -7), today
+	case RangeConstant, RangeLast1h:
+		return traceStart.Add(-time.Hour), traceStart
 	default:
-		return traceStartTSNano / 1e6, "1h"
+		if duration, ok := r.getLastDuration(); ok {
+			return traceStart.Add(-duration), traceStart
+		}
+		return traceStart.Add(-time.Hour), traceStart
 	}
 }
 
+// GetDuration returns the window size of range for a trace started now, see GetWindow.
 func (r ThresholdRange) GetDuration() (duration string) {
+	start, end := r.GetWindow(time.Now().UnixNano(), time.Local)
+	return FormatPromDuration(end.Sub(start))
+}
+
+func (r ThresholdRange) IsValid() bool {
 	switch r {
-	case RangeLast1h:
-		return "1h"
-	case RangeYesterday:
-		return "24h"
-	case RangeConstant:
-		return "1h"
+	case RangeLast1h, RangeYesterday, RangeConstant, RangeSameHourLastWeek, RangeLast7d:
+		return true
+	default:
+		_, ok := r.getLastDuration()
+		return ok
+	}
+}
+
+func (r ThresholdRange) getLastDuration() (time.Duration, bool) {
+	value, found := strings.CutPrefix(string(r), "last")
+	if !found {
+		return 0, false
+	}
+	duration, err := ParsePromDuration(value)
+	if err != nil || duration <= 0 {
+		return 0, false
+	}
+	return duration, true
+}
+
+// ParsePromDuration parses single unit duration like 30s, 5m, 6h, 7d or 1w.
+func ParsePromDuration(value string) (time.Duration, error) {
+	if len(value) < 2 {
+		return 0, strconv.ErrSyntax
+	}
+	number, err := strconv.ParseInt(value[:len(value)-1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	var unit time.Duration
+	switch value[len(value)-1] {
+	case 's':
+		unit = time.Second
+	case 'm':
+		unit = time.Minute
+	case 'h':
+		unit = time.Hour
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	default:
+		return 0, strconv.ErrSyntax
+	}
+	return time.Duration(number) * unit, nil
+}
+
+func FormatPromDuration(duration time.Duration) string {
+	switch {
+	case duration%time.Hour == 0:
+		return strconv.FormatInt(int64(duration/time.Hour), 10) + "h"
+	case duration%time.Minute == 0:
+		return strconv.FormatInt(int64(duration/time.Minute), 10) + "m"
 	default:
-		return "1h"
+		return strconv.FormatInt(int64(duration/time.Second), 10) + "s"
 	}
 }
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThresholdRange_GetRangeInLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// 2024-03-11 10:30 EDT, DST started at 2024-03-10 02:00.
+	traceStart := time.Date(2024, 3, 11, 10, 30, 0, 0, loc)
+	today := time.Date(2024, 3, 11, 0, 0, 0, 0, loc)
+	tests := []struct {
+		name         string
+		r            ThresholdRange
+		wantStartTS  int64
+		wantDuration string
+	}{
+		{"last1h", RangeLast1h, traceStart.UnixMilli(), "1h"},
+		{"yesterday", RangeYesterday, today.UnixMilli(), "23h"},
+		{"sameHourLastWeek", RangeSameHourLastWeek, time.Date(2024, 3, 4, 11, 0, 0, 0, loc).UnixMilli(), "1h"},
+		{"last7d", RangeLast7d, today.UnixMilli(), "167h"},
+		{"last30m", NewLastRange(30 * time.Minute), traceStart.UnixMilli(), "30m"},
+		{"last3d", ThresholdRange("last3d"), traceStart.UnixMilli(), "72h"},
+		{"unknown", ThresholdRange("unknown"), traceStart.UnixMilli(), "1h"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotStartTS, gotDuration := tt.r.GetRangeInLocation(traceStart.UnixNano(), loc)
+			if gotStartTS != tt.wantStartTS || gotDuration != tt.wantDuration {
+				t.Errorf("GetRangeInLocation() = (%d, %s), want (%d, %s)", gotStartTS, gotDuration, tt.wantStartTS, tt.wantDuration)
+			}
+		})
+	}
+}
+
+func TestThresholdRange_GetDuration(t *testing.T) {
+	for _, r := range []ThresholdRange{RangeLast1h, RangeYesterday, RangeSameHourLastWeek, RangeLast7d, NewLastRange(30 * time.Minute)} {
+		start, end := r.GetWindow(time.Now().UnixNano(), time.Local)
+		if got, want := r.GetDuration(), FormatPromDuration(end.Sub(start)); got != want {
+			t.Errorf("%s.GetDuration() = %s, want %s", r, got, want)
+		}
+	}
+}
+
+func TestThresholdType_GetBaseline(t *testing.T) {
+	tests := []struct {
+		name          string
+		t             ThresholdType
+		value         float64
+		multiple      float64
+		wantThreshold uint64
+		wantBaseline  uint64
+	}{
+		{"p90", P90ThresholdType, 3e8, 3, 3e8, 1e8},
+		{"avg without multiple", AvgThresholdType, 3e8, 0, 3e8, 0},
+		{"absolute in ms", AbsoluteThresholdType, 200, 3, 2e8, 2e8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.GetThreshold(tt.value); got != tt.wantThreshold {
+				t.Errorf("GetThreshold() = %d, want %d", got, tt.wantThreshold)
+			}
+			if got := tt.t.GetBaseline(tt.value, tt.multiple); got != tt.wantBaseline {
+				t.Errorf("GetBaseline() = %d, want %d", got, tt.wantBaseline)
+			}
+		})
+	}
+}
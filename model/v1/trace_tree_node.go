@@ -4,39 +4,53 @@ import (
 	"fmt"
 )
 
+type P90Source string
+
+const (
+	P90FromThreshold P90Source = "threshold"
+	P90FromPQL       P90Source = "pql"
+)
+
 type TraceTreeNode struct {
 	Id                string         `json:"id"`
+	InstanceKey       string         `json:"instanceKey,omitempty"`
 	ServiceName       string         `json:"serviceName"`
 	Url               string         `json:"url"`
 	StartTime         uint64         `json:"startTime"`
 	TotalTime         uint64         `json:"totalTime"`
 	ClientTime        uint64         `json:"clientTime"`
 	P90               uint64         `json:"p90"`
+	P90Source         P90Source      `json:"p90Source,omitempty"`
 	ThresholdType     ThresholdType  `json:"threshold_type"`
 	ThresholdValue    float64        `json:"threshold_value"`
 	ThresholdRange    ThresholdRange `json:"threshold_range"`
 	ThresholdMultiple float64        `json:"threshold_multiple"`
 
-	IsTraced       bool             `json:"isTraced"`
-	IsProfiled     bool             `json:"isProfiled"`
-	Pod            string           `json:"pod"`
-	PodNS          string           `json:"podNS"`
-	Workload       string           `json:"workload"`
-	WorkloadType   string           `json:"workloadType"`
-	IsPath         bool             `json:"isPath"`
-	IsMutated      bool             `json:"isMutated"`
-	MissVNode      bool             `json:"missVNode"`
-	SelfTime       uint64           `json:"selfTime"`
-	SelfP90        uint64           `json:"selfP90"`
-	MutatedValue   int64            `json:"mutatedValue"`
-	SpanId         string           `json:"spanId"`
-	OriginalSpanId string           `json:"-"`
-	ContainerId    string           `json:"-"`
-	NodeIp         string           `json:"-"`
-	NodeName       string           `json:"-"`
-	Pid            uint32           `json:"-"`
-	Children       []*TraceTreeNode `json:"children"`
-	Parent         *TraceTreeNode   `json:"-"`
+	IsTraced       bool            `json:"isTraced"`
+	IsProfiled     bool            `json:"isProfiled"`
+	Pod            string          `json:"pod"`
+	PodNS          string          `json:"podNS"`
+	Workload       string          `json:"workload"`
+	WorkloadType   string          `json:"workloadType"`
+	IsPath         bool            `json:"isPath"`
+	IsMutated      bool            `json:"isMutated"`
+	MissVNode      bool            `json:"missVNode"`
+	SelfTime       uint64          `json:"selfTime"`
+	SelfP90        uint64          `json:"selfP90"`
+	MutatedValue   int64           `json:"mutatedValue"`
+	SpanId         string          `json:"spanId"`
+	OriginalSpanId string          `json:"-"`
+	ContainerId    string          `json:"-"`
+	NodeIp         string          `json:"-"`
+	NodeName       string          `json:"-"`
+	Pid            uint32          `json:"-"`
+	CallPatterns   []*CallPattern  `json:"callPatterns,omitempty"`
+	Collapsed      *CollapsedNodes `json:"collapsed,omitempty"`
+	CpuBreakdown   *CpuBreakdown   `json:"cpuBreakdown,omitempty"`
+	// Why the traced node is not profiled, see AgentEventRegistry.
+	NotProfiledReason string           `json:"notProfiledReason,omitempty"`
+	Children          []*TraceTreeNode `json:"children"`
+	Parent            *TraceTreeNode   `json:"-"`
 }
 
 func (node *TraceTreeNode) GetMutatedSpanId() string {
@@ -51,6 +65,19 @@ func (node *TraceTreeNode) GetMutatedSpanId() string {
 	return ""
 }
 
+// GetMutatedNode returns the mutated node along the path, nil if not found.
+func (node *TraceTreeNode) GetMutatedNode() *TraceTreeNode {
+	if node.IsMutated {
+		return node
+	}
+	for _, child := range node.Children {
+		if child.IsPath {
+			return child.GetMutatedNode()
+		}
+	}
+	return nil
+}
+
 func (node *TraceTreeNode) HasVNodeChild() bool {
 	for _, child := range node.Children {
 		if child.MissVNode {
@@ -62,9 +89,13 @@ func (node *TraceTreeNode) HasVNodeChild() bool {
 
 func (node *TraceTreeNode) SetSampled(sampledTrace *Trace) {
 	node.Id = sampledTrace.GetInstanceId()
+	node.InstanceKey = sampledTrace.GetInstanceKey()
 	sampledTraceLabel := sampledTrace.Labels
 	node.Url = sampledTraceLabel.Url
-	node.P90 = uint64(sampledTraceLabel.ThresholdValue / sampledTraceLabel.ThresholdMultiple)
+	node.P90 = sampledTraceLabel.ThresholdType.GetBaseline(sampledTraceLabel.ThresholdValue, sampledTraceLabel.ThresholdMultiple)
+	if node.P90 > 0 {
+		node.P90Source = P90FromThreshold
+	}
 	node.ThresholdValue = sampledTraceLabel.ThresholdValue
 	node.ThresholdType = sampledTraceLabel.ThresholdType
 	node.ThresholdRange = sampledTraceLabel.ThresholdRange
@@ -112,7 +143,7 @@ func (node *TraceTreeNode) CalcMutateValue() int64 {
 
 		var outP90 uint64 = 0
 		for _, child := range node.Children {
-			if child.IsTraced {
+			if child.IsTraced || child.P90Source == P90FromPQL {
 				outP90 += child.P90
 			} else {
 				outP90 += child.TotalTime
@@ -0,0 +1,262 @@
+package model
+
+import "sort"
+
+type PruneConfig struct {
+	// Trees with no more than MaxNodes nodes are not pruned.
+	MaxNodes int
+	// Slowest siblings kept besides the path and error nodes.
+	TopSlowSiblings int
+}
+
+func DefaultPruneConfig() *PruneConfig {
+	return &PruneConfig{
+		MaxNodes:        200,
+		TopSlowSiblings: 3,
+	}
+}
+
+// CollapsedNodes is the stats of identical siblings (same service and url) collapsed into one node.
+type CollapsedNodes struct {
+	Count   int    `json:"count"`
+	MinTime uint64 `json:"minTime"`
+	MaxTime uint64 `json:"maxTime"`
+	AvgTime uint64 `json:"avgTime"`
+	SumTime uint64 `json:"sumTime"`
+	// Nodes removed from tree, include collapsed siblings and their descendants.
+	ElidedNodes int `json:"elidedNodes"`
+}
+
+func (collapsed *CollapsedNodes) add(totalTime uint64, nodes int) {
+	collapsed.merge(&CollapsedNodes{Count: 1, MinTime: totalTime, MaxTime: totalTime, SumTime: totalTime, ElidedNodes: nodes})
+}
+
+// merge adds the stats of node which is already collapsed by a previous prune.
+func (collapsed *CollapsedNodes) merge(other *CollapsedNodes) {
+	if collapsed.Count == 0 || other.MinTime < collapsed.MinTime {
+		collapsed.MinTime = other.MinTime
+	}
+	if other.MaxTime > collapsed.MaxTime {
+		collapsed.MaxTime = other.MaxTime
+	}
+	collapsed.Count += other.Count
+	collapsed.SumTime += other.SumTime
+	collapsed.AvgTime = collapsed.SumTime / uint64(collapsed.Count)
+	collapsed.ElidedNodes += other.ElidedNodes
+}
+
+// PruneRelationTree prunes RelationTree and records the number of elided nodes.
+func (data *CameraNodeReportData) PruneRelationTree(cfg *PruneConfig) {
+	data.ElidedNodes += PruneTraceTree(data.RelationTree, cfg)
+}
+
+// PruneRelationTree prunes RelationTree and records the number of elided nodes.
+func (data *ErrorReportData) PruneRelationTree(cfg *PruneConfig) {
+	data.ElidedNodes += PruneErrorTree(data.RelationTree, cfg)
+}
+
+// PruneTraceTree keeps the path to mutated node and top slow siblings when tree is larger than MaxNodes,
+// other siblings are collapsed into one node per service and url without their descendants.
+// Fewer slow siblings are kept until tree fits MaxNodes, path nodes are always kept. Returns the number of elided nodes.
+func PruneTraceTree(root *TraceTreeNode, cfg *PruneConfig) int {
+	if root == nil {
+		return 0
+	}
+	return pruneTree(root, cfg)
+}
+
+// PruneErrorTree keeps the path to root cause, error nodes and top slow siblings when tree is larger than MaxNodes,
+// other siblings are collapsed same as PruneTraceTree. Returns the number of elided nodes.
+func PruneErrorTree(root *ErrorTreeNode, cfg *PruneConfig) int {
+	if root == nil {
+		return 0
+	}
+	return pruneTree(root, cfg)
+}
+
+// prunableNode is implemented by *TraceTreeNode and *ErrorTreeNode.
+type prunableNode[T any] interface {
+	countNodes() int
+	pruneChildren() []T
+	setPruneChildren(children []T)
+	// mustKeep reports whether node is on the path or should be shown even if it is fast.
+	mustKeep() bool
+	pruneKey() string
+	pruneTime() uint64
+	pruneCollapsed() *CollapsedNodes
+	// collapsedCopy returns a copy of node without children.
+	collapsedCopy(collapsed *CollapsedNodes) T
+}
+
+func pruneTree[T prunableNode[T]](root T, cfg *PruneConfig) int {
+	if cfg == nil {
+		cfg = DefaultPruneConfig()
+	}
+	if root.countNodes() <= cfg.MaxNodes {
+		return 0
+	}
+	elided := 0
+	for topN := cfg.TopSlowSiblings; topN >= 0; topN-- {
+		elided += pruneNode(root, topN)
+		if root.countNodes() <= cfg.MaxNodes {
+			break
+		}
+	}
+	return elided
+}
+
+func pruneNode[T prunableNode[T]](node T, topN int) int {
+	nodeChildren := node.pruneChildren()
+	if len(nodeChildren) == 0 {
+		return 0
+	}
+	kept := make([]bool, len(nodeChildren))
+	candidates := make([]int, 0, len(nodeChildren))
+	for i, child := range nodeChildren {
+		if child.mustKeep() {
+			kept[i] = true
+		} else {
+			candidates = append(candidates, i)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return nodeChildren[candidates[i]].pruneTime() > nodeChildren[candidates[j]].pruneTime()
+	})
+	for i := 0; i < topN && i < len(candidates); i++ {
+		kept[candidates[i]] = true
+	}
+
+	groups := make(map[string][]T)
+	for i, child := range nodeChildren {
+		if !kept[i] {
+			key := child.pruneKey()
+			groups[key] = append(groups[key], child)
+		}
+	}
+
+	elided := 0
+	children := make([]T, 0, len(nodeChildren))
+	for i, child := range nodeChildren {
+		if kept[i] {
+			elided += pruneNode(child, topN)
+			children = append(children, child)
+			continue
+		}
+		key := child.pruneKey()
+		group, exist := groups[key]
+		if !exist {
+			// Collapsed into the first node of group.
+			continue
+		}
+		delete(groups, key)
+		if len(group) == 1 && len(child.pruneChildren()) == 0 {
+			children = append(children, child)
+			continue
+		}
+		collapsedNode, groupElided := collapseNodes(group)
+		elided += groupElided
+		children = append(children, collapsedNode)
+	}
+	node.setPruneChildren(children)
+	return elided
+}
+
+// collapseNodes merges siblings into a copy of the slowest one, the copy itself is not counted as elided.
+func collapseNodes[T prunableNode[T]](nodes []T) (T, int) {
+	slowest := nodes[0]
+	collapsed := &CollapsedNodes{}
+	elided := -1
+	for _, node := range nodes {
+		if node.pruneTime() > slowest.pruneTime() {
+			slowest = node
+		}
+		count := node.countNodes()
+		elided += count
+		if previous := node.pruneCollapsed(); previous != nil {
+			collapsed.merge(previous)
+			collapsed.ElidedNodes += count - 1
+		} else {
+			collapsed.add(node.pruneTime(), count-1)
+		}
+	}
+	collapsed.ElidedNodes += len(nodes) - 1
+	return slowest.collapsedCopy(collapsed), elided
+}
+
+func (node *TraceTreeNode) countNodes() int {
+	count := 1
+	for _, child := range node.Children {
+		count += child.countNodes()
+	}
+	return count
+}
+
+func (node *TraceTreeNode) pruneChildren() []*TraceTreeNode {
+	return node.Children
+}
+
+func (node *TraceTreeNode) setPruneChildren(children []*TraceTreeNode) {
+	node.Children = children
+}
+
+func (node *TraceTreeNode) mustKeep() bool {
+	return node.IsPath || node.IsMutated
+}
+
+func (node *TraceTreeNode) pruneKey() string {
+	return node.ServiceName + "|" + node.Url
+}
+
+func (node *TraceTreeNode) pruneTime() uint64 {
+	return node.TotalTime
+}
+
+func (node *TraceTreeNode) pruneCollapsed() *CollapsedNodes {
+	return node.Collapsed
+}
+
+func (node *TraceTreeNode) collapsedCopy(collapsed *CollapsedNodes) *TraceTreeNode {
+	collapsedNode := *node
+	collapsedNode.Children = nil
+	collapsedNode.Collapsed = collapsed
+	return &collapsedNode
+}
+
+func (node *ErrorTreeNode) countNodes() int {
+	count := 1
+	for _, child := range node.Children {
+		count += child.countNodes()
+	}
+	return count
+}
+
+func (node *ErrorTreeNode) pruneChildren() []*ErrorTreeNode {
+	return node.Children
+}
+
+func (node *ErrorTreeNode) setPruneChildren(children []*ErrorTreeNode) {
+	node.Children = children
+}
+
+func (node *ErrorTreeNode) mustKeep() bool {
+	return node.IsPath || node.IsMutated || node.IsError
+}
+
+func (node *ErrorTreeNode) pruneKey() string {
+	return node.ServiceName + "|" + node.Url
+}
+
+func (node *ErrorTreeNode) pruneTime() uint64 {
+	return node.TotalTime
+}
+
+func (node *ErrorTreeNode) pruneCollapsed() *CollapsedNodes {
+	return node.Collapsed
+}
+
+func (node *ErrorTreeNode) collapsedCopy(collapsed *CollapsedNodes) *ErrorTreeNode {
+	collapsedNode := *node
+	collapsedNode.Children = nil
+	collapsedNode.Collapsed = collapsed
+	return &collapsedNode
+}
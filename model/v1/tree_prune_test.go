@@ -0,0 +1,125 @@
+package model
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPruneTraceTree(t *testing.T) {
+	root := &TraceTreeNode{ServiceName: "gateway", Url: "/batch", TotalTime: 1000, IsPath: true}
+	mutated := &TraceTreeNode{ServiceName: "order", Url: "/order/{id}", TotalTime: 10, IsPath: true, IsMutated: true}
+	root.AddChild(mutated)
+	for i := 0; i < 100; i++ {
+		child := &TraceTreeNode{ServiceName: "order", Url: "/order/{id}", TotalTime: uint64(i + 1), SpanId: fmt.Sprintf("s%d", i)}
+		child.AddChild(&TraceTreeNode{ServiceName: "stock", Url: "/stock"})
+		root.AddChild(child)
+	}
+	root.AddChild(&TraceTreeNode{ServiceName: "user", Url: "/user", TotalTime: 1})
+
+	if elided := PruneTraceTree(root, &PruneConfig{MaxNodes: 300, TopSlowSiblings: 2}); elided != 0 {
+		t.Fatalf("small tree is pruned, elided = %d", elided)
+	}
+
+	elided := PruneTraceTree(root, &PruneConfig{MaxNodes: 100, TopSlowSiblings: 2})
+	// 98 order nodes and their stock children are collapsed into one node.
+	if elided != 98*2-1 {
+		t.Errorf("elided = %d, want %d", elided, 98*2-1)
+	}
+	if len(root.Children) != 5 {
+		t.Fatalf("children = %d, want 5", len(root.Children))
+	}
+	if root.Children[0] != mutated {
+		t.Errorf("mutated node is not kept")
+	}
+	if root.Children[1].SpanId != "s97" || root.Children[1].Collapsed == nil {
+		t.Fatalf("collapsed node is not at the position of first sibling")
+	}
+	collapsed := root.Children[1].Collapsed
+	if collapsed.Count != 98 || collapsed.MinTime != 1 || collapsed.MaxTime != 98 || collapsed.ElidedNodes != elided {
+		t.Errorf("collapsed = %+v", collapsed)
+	}
+	if root.Children[2].TotalTime != 99 || root.Children[3].TotalTime != 100 || len(root.Children[3].Children) != 1 {
+		t.Errorf("top slow siblings are not kept")
+	}
+	if root.Children[4].ServiceName != "user" || root.Children[4].Collapsed != nil {
+		t.Errorf("single sibling should be kept")
+	}
+}
+
+func TestPruneTraceTree_MaxNodes(t *testing.T) {
+	root := &TraceTreeNode{ServiceName: "gateway", Url: "/batch", TotalTime: 1000, IsPath: true}
+	mutated := &TraceTreeNode{ServiceName: "order", Url: "/order", TotalTime: 500, IsPath: true, IsMutated: true}
+	root.AddChild(mutated)
+	for i := 0; i < 5; i++ {
+		// Each sibling has unique url and 20 descendants.
+		child := &TraceTreeNode{ServiceName: "user", Url: fmt.Sprintf("/user/%d", i), TotalTime: uint64(i + 1)}
+		for j := 0; j < 20; j++ {
+			child.AddChild(&TraceTreeNode{ServiceName: "db", Url: "SELECT", TotalTime: 1})
+		}
+		root.AddChild(child)
+	}
+
+	tests := []struct {
+		name       string
+		cfg        *PruneConfig
+		wantNodes  int
+		wantElided int
+	}{
+		// 3 fast siblings are elided with 20 descendants, 2 slowest siblings keep 2 db and 1 collapsed db.
+		{"top2", &PruneConfig{MaxNodes: 50, TopSlowSiblings: 2}, 2 + 5 + 3*2, 20*3 + 17*2},
+		// Fewer slow siblings are kept until tree fits MaxNodes.
+		{"shrink", &PruneConfig{MaxNodes: 10, TopSlowSiblings: 2}, 2 + 5 + 2, 20*3 + 17*2 + 3 + 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := copyTraceTree(root)
+			elided := PruneTraceTree(tree, tt.cfg)
+			if elided != tt.wantElided {
+				t.Errorf("elided = %d, want %d", elided, tt.wantElided)
+			}
+			if nodes := tree.countNodes(); nodes != tt.wantNodes {
+				t.Errorf("nodes = %d, want %d", nodes, tt.wantNodes)
+			}
+			if tree.Children[0].Url != mutated.Url {
+				t.Errorf("mutated node is not kept")
+			}
+			for _, child := range tree.Children[1:4] {
+				if len(child.Children) != 0 || child.Collapsed == nil || child.Collapsed.Count != 1 || child.Collapsed.ElidedNodes != 20 {
+					t.Errorf("fast sibling %s is not elided, collapsed = %+v", child.Url, child.Collapsed)
+				}
+			}
+		})
+	}
+}
+
+func TestPruneErrorTree(t *testing.T) {
+	root := &ErrorTreeNode{ServiceName: "gateway", Url: "/batch", TotalTime: 1000, IsPath: true}
+	errorNode := &ErrorTreeNode{ServiceName: "order", Url: "/order", TotalTime: 1, IsError: true}
+	root.AddChild(errorNode)
+	for i := 0; i < 50; i++ {
+		child := &ErrorTreeNode{ServiceName: "user", Url: "/user", TotalTime: uint64(i + 1)}
+		child.AddChild(&ErrorTreeNode{ServiceName: "db", Url: "SELECT"})
+		root.AddChild(child)
+	}
+
+	elided := PruneErrorTree(root, &PruneConfig{MaxNodes: 10, TopSlowSiblings: 1})
+	// 49 user nodes and their db children are collapsed into one node.
+	if elided != 49*2-1 {
+		t.Errorf("elided = %d, want %d", elided, 49*2-1)
+	}
+	if len(root.Children) != 3 || root.Children[0] != errorNode {
+		t.Fatalf("children = %d, error node is not kept", len(root.Children))
+	}
+	if collapsed := root.Children[1].Collapsed; collapsed == nil || collapsed.Count != 49 || collapsed.MaxTime != 49 {
+		t.Errorf("collapsed = %+v", collapsed)
+	}
+}
+
+func copyTraceTree(node *TraceTreeNode) *TraceTreeNode {
+	copied := *node
+	copied.Children = nil
+	for _, child := range node.Children {
+		copied.AddChild(copyTraceTree(child))
+	}
+	return &copied
+}
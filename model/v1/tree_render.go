@@ -0,0 +1,255 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	ansiReset  = "\033[0m"
+	ansiRed    = "\033[31m"
+	ansiYellow = "\033[33m"
+	ansiGray   = "\033[90m"
+	ansiBold   = "\033[1m"
+)
+
+type TreeRenderOptions struct {
+	// Lines longer than MaxWidth are truncated with "...", 0 is unlimited.
+	MaxWidth int
+	// Children deeper than MaxDepth are omitted, 0 is unlimited.
+	MaxDepth int
+	// Color markers with ANSI escape codes.
+	Color bool
+}
+
+func DefaultTreeRenderOptions() *TreeRenderOptions {
+	return &TreeRenderOptions{
+		MaxWidth: 160,
+	}
+}
+
+// textSegment is a part of line, color is not counted in width.
+type textSegment struct {
+	text  string
+	color string
+}
+
+type treeRenderer struct {
+	w    io.Writer
+	opts *TreeRenderOptions
+	err  error
+}
+
+// RenderTraceTree writes the tree as indented ascii tree, eg.
+//
+//	entry GET /order 120.00ms self=10.00ms p90=50.00ms [path]
+//	└── order SELECT 110.00ms self=100.00ms p90=20.00ms [path] [MUTATED]
+func RenderTraceTree(w io.Writer, root *TraceTreeNode, opts *TreeRenderOptions) error {
+	renderer := newTreeRenderer(w, opts)
+	if root != nil {
+		renderTree(renderer, root, "", "", 0)
+	}
+	return renderer.err
+}
+
+// RenderErrorTree writes the tree as indented ascii tree with the root cause exception of each error node,
+// self time of error node is the time not spent in its children.
+func RenderErrorTree(w io.Writer, root *ErrorTreeNode, opts *TreeRenderOptions) error {
+	renderer := newTreeRenderer(w, opts)
+	if root != nil {
+		renderTree(renderer, root, "", "", 0)
+	}
+	return renderer.err
+}
+
+func (node *TraceTreeNode) RenderString(opts *TreeRenderOptions) string {
+	var text strings.Builder
+	RenderTraceTree(&text, node, opts)
+	return text.String()
+}
+
+func (node *ErrorTreeNode) RenderString(opts *TreeRenderOptions) string {
+	var text strings.Builder
+	RenderErrorTree(&text, node, opts)
+	return text.String()
+}
+
+func newTreeRenderer(w io.Writer, opts *TreeRenderOptions) *treeRenderer {
+	if opts == nil {
+		opts = DefaultTreeRenderOptions()
+	}
+	return &treeRenderer{w: w, opts: opts}
+}
+
+// renderableNode is implemented by TraceTreeNode and ErrorTreeNode to share the rendering of tree.
+type renderableNode[T any] interface {
+	renderView() *nodeView
+	renderChildren() []T
+}
+
+// nodeView is the fields shown in the line of node, markers are node specific and shown at the end.
+type nodeView struct {
+	serviceName string
+	url         string
+	totalTime   uint64
+	selfTime    uint64
+	p90         uint64
+	collapsed   *CollapsedNodes
+	isPath      bool
+	missVNode   bool
+	markers     []textSegment
+}
+
+func (node *TraceTreeNode) renderView() *nodeView {
+	view := &nodeView{
+		serviceName: node.ServiceName,
+		url:         node.Url,
+		totalTime:   node.TotalTime,
+		selfTime:    node.SelfTime,
+		p90:         node.P90,
+		collapsed:   node.Collapsed,
+		isPath:      node.IsPath,
+		missVNode:   node.MissVNode,
+	}
+	if node.IsMutated {
+		view.markers = append(view.markers, textSegment{text: " [MUTATED]", color: ansiRed})
+	}
+	return view
+}
+
+func (node *TraceTreeNode) renderChildren() []*TraceTreeNode {
+	return node.Children
+}
+
+func (node *ErrorTreeNode) renderView() *nodeView {
+	view := &nodeView{
+		serviceName: node.ServiceName,
+		url:         node.Url,
+		totalTime:   node.TotalTime,
+		p90:         node.P90,
+		collapsed:   node.Collapsed,
+		isPath:      node.IsPath,
+		missVNode:   node.MissVNode,
+	}
+	// Self time is not kept by error tree, it is the time not spent in children.
+	var childTime uint64
+	for _, child := range node.Children {
+		childTime += child.TotalTime
+	}
+	if node.TotalTime > childTime {
+		view.selfTime = node.TotalTime - childTime
+	}
+	if node.IsError {
+		view.markers = append(view.markers, textSegment{text: " [error]", color: ansiRed})
+	}
+	if node.IsMutated {
+		view.markers = append(view.markers, textSegment{text: " [ROOT CAUSE]", color: ansiRed})
+	}
+	if exception := node.GetRootCauseError(); exception != nil {
+		message := exception.Type
+		if exception.Message != "" {
+			message = fmt.Sprintf("%s: %s", exception.Type, firstLine(exception.Message))
+		}
+		view.markers = append(view.markers, textSegment{text: " " + message, color: ansiRed})
+	}
+	return view
+}
+
+func (node *ErrorTreeNode) renderChildren() []*ErrorTreeNode {
+	return node.Children
+}
+
+func renderTree[T renderableNode[T]](r *treeRenderer, node T, prefix string, childPrefix string, depth int) {
+	view := node.renderView()
+	segments := []textSegment{
+		{text: prefix, color: ansiGray},
+		{text: view.serviceName, color: ansiBold},
+		{text: " " + view.url},
+		{text: " " + formatNs(view.totalTime)},
+	}
+	if view.selfTime > 0 {
+		segments = append(segments, textSegment{text: " self=" + formatNs(view.selfTime)})
+	}
+	if view.p90 > 0 {
+		segments = append(segments, textSegment{text: " p90=" + formatNs(view.p90), color: ansiGray})
+	}
+	if view.collapsed != nil && view.collapsed.Count > 1 {
+		segments = append(segments, textSegment{text: fmt.Sprintf(" x%d", view.collapsed.Count), color: ansiGray})
+	}
+	if view.isPath {
+		segments = append(segments, textSegment{text: " [path]", color: ansiYellow})
+	}
+	if view.missVNode {
+		segments = append(segments, textSegment{text: " [missing]", color: ansiGray})
+	}
+	r.writeLine(append(segments, view.markers...))
+
+	children := node.renderChildren()
+	if r.opts.MaxDepth > 0 && depth >= r.opts.MaxDepth {
+		if len(children) > 0 {
+			r.writeLine([]textSegment{{text: childPrefix + fmt.Sprintf("└── ... %d children", len(children)), color: ansiGray}})
+		}
+		return
+	}
+	for i, child := range children {
+		if i == len(children)-1 {
+			renderTree(r, child, childPrefix+"└── ", childPrefix+"    ", depth+1)
+		} else {
+			renderTree(r, child, childPrefix+"├── ", childPrefix+"│   ", depth+1)
+		}
+	}
+}
+
+// writeLine truncates the segments to MaxWidth runes and writes them with color.
+func (r *treeRenderer) writeLine(segments []textSegment) {
+	if r.err != nil {
+		return
+	}
+	var line strings.Builder
+	width := 0
+	truncated := false
+	for _, segment := range segments {
+		text := segment.text
+		if r.opts.MaxWidth > 0 {
+			runes := utf8.RuneCountInString(text)
+			if width+runes > r.opts.MaxWidth {
+				text = truncateRunes(text, r.opts.MaxWidth-width)
+				truncated = true
+			}
+			width += runes
+		}
+		if r.opts.Color && segment.color != "" && text != "" {
+			line.WriteString(segment.color)
+			line.WriteString(text)
+			line.WriteString(ansiReset)
+		} else {
+			line.WriteString(text)
+		}
+		if truncated {
+			break
+		}
+	}
+	line.WriteString("\n")
+	_, r.err = io.WriteString(r.w, line.String())
+}
+
+// truncateRunes keeps at most width runes of text and ends with "..." if truncated.
+func truncateRunes(text string, width int) string {
+	if width <= 3 {
+		return strings.Repeat(".", max(width, 0))
+	}
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:width-3]) + "..."
+}
+
+func firstLine(text string) string {
+	if index := strings.IndexByte(text, '\n'); index != -1 {
+		return strings.TrimRight(text[:index], "\r")
+	}
+	return text
+}
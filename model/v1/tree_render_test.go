@@ -0,0 +1,81 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRenderTraceTree(t *testing.T) {
+	root := &TraceTreeNode{ServiceName: "gateway", Url: "GET /order", TotalTime: 120e6, SelfTime: 10e6, P90: 50e6, IsPath: true}
+	root.AddChild(&TraceTreeNode{ServiceName: "user", Url: "GET /user", TotalTime: 5e6, SelfTime: 5e6})
+	mutated := &TraceTreeNode{ServiceName: "order", Url: "SELECT order", TotalTime: 110e6, SelfTime: 100e6, P90: 20e6, IsPath: true, IsMutated: true}
+	mutated.AddChild(&TraceTreeNode{ServiceName: "mysql", Url: "SELECT", TotalTime: 10e6, MissVNode: true})
+	root.AddChild(mutated)
+
+	want := "gateway GET /order 120.00ms self=10.00ms p90=50.00ms [path]\n" +
+		"├── user GET /user 5.00ms self=5.00ms\n" +
+		"└── order SELECT order 110.00ms self=100.00ms p90=20.00ms [path] [MUTATED]\n" +
+		"    └── mysql SELECT 10.00ms [missing]\n"
+	if got := root.RenderString(nil); got != want {
+		t.Errorf("render =\n%s\nwant =\n%s", got, want)
+	}
+
+	got := root.RenderString(&TreeRenderOptions{MaxDepth: 1})
+	if !strings.Contains(got, "    └── ... 1 children\n") || strings.Contains(got, "mysql") {
+		t.Errorf("render with max depth =\n%s", got)
+	}
+
+	got = root.RenderString(&TreeRenderOptions{MaxWidth: 20, Color: true})
+	for _, line := range strings.Split(strings.TrimSuffix(got, "\n"), "\n") {
+		plain := stripAnsi(line)
+		if width := utf8.RuneCountInString(plain); width > 20 {
+			t.Errorf("line %q is wider than 20", plain)
+		}
+	}
+	if !strings.Contains(got, ansiBold+"gateway"+ansiReset) {
+		t.Errorf("render with color =\n%q", got)
+	}
+}
+
+func TestRenderErrorTree(t *testing.T) {
+	root := &ErrorTreeNode{ServiceName: "gateway", Url: "GET /order", TotalTime: 3e6, P90: 2e6, IsPath: true, IsError: true}
+	root.AddChild(&ErrorTreeNode{
+		ServiceName: "order", Url: "GET /order/{id}", TotalTime: 2e6, IsPath: true, IsError: true, IsMutated: true,
+		ErrorSpans: []*ErrorSpan{{Exceptions: []*Exception{
+			{Timestamp: 2, Type: "java.lang.RuntimeException", Message: "wrapped"},
+			{Timestamp: 1, Type: "java.sql.SQLException", Message: "connection refused\n\tat Driver.connect"},
+		}}},
+	})
+
+	want := "gateway GET /order 3.00ms self=1.00ms p90=2.00ms [path] [error]\n" +
+		"└── order GET /order/{id} 2.00ms self=2.00ms [path] [error] [ROOT CAUSE] java.sql.SQLException: connection refused\n"
+	if got := root.RenderString(nil); got != want {
+		t.Errorf("render =\n%s\nwant =\n%s", got, want)
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  string
+	}{
+		{"order", 10, "order"},
+		{"order-service", 8, "order..."},
+		{"订单服务接口", 5, "订单..."},
+		{"order", 2, ".."},
+	}
+	for _, tt := range tests {
+		if got := truncateRunes(tt.text, tt.width); got != tt.want {
+			t.Errorf("truncateRunes(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
+
+func stripAnsi(text string) string {
+	for _, code := range []string{ansiReset, ansiRed, ansiYellow, ansiGray, ansiBold} {
+		text = strings.ReplaceAll(text, code, "")
+	}
+	return text
+}
@@ -4,6 +4,7 @@ go 1.21
 
 require (
 	github.com/ClickHouse/clickhouse-go/v2 v2.26.0
+	github.com/CloudDetail/apo-module/model v0.0.0-00000000000000-000000000000
 	github.com/CloudDetail/apo-module/slo/api v0.0.0-00000000000000-000000000000
 	github.com/hashicorp/golang-lru/v2 v2.0.7
 	github.com/olivere/elastic/v7 v7.0.32
@@ -31,11 +32,16 @@ require (
 	github.com/prometheus/client_model v0.5.0 // indirect
 	github.com/segmentio/asm v1.2.0 // indirect
 	github.com/shopspring/decimal v1.4.0 // indirect
+	go.opentelemetry.io/collector/semconv v0.97.0 // indirect
 	go.opentelemetry.io/otel v1.26.0 // indirect
 	go.opentelemetry.io/otel/trace v1.26.0 // indirect
 	golang.org/x/sys v0.18.0 // indirect
+	google.golang.org/appengine v1.6.8 // indirect
 	google.golang.org/protobuf v1.33.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
-replace github.com/CloudDetail/apo-module/slo/api => ../api
+replace (
+	github.com/CloudDetail/apo-module/model => ../../model
+	github.com/CloudDetail/apo-module/slo/api => ../api
+)
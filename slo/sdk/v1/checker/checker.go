@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	cmodel "github.com/CloudDetail/apo-module/model/v1"
 	"github.com/CloudDetail/apo-module/slo/api/v1"
 	"github.com/CloudDetail/apo-module/slo/api/v1/model"
 	"github.com/CloudDetail/apo-module/slo/sdk/v1/pql"
@@ -117,11 +118,10 @@ func (p *PrometheusChecker) GetHistorySLO(key model.SLOEntryKey, endTime int64)
 		sloHistory.SuccessRate = successRates
 	}
 
-	todayTsMill := getDayUnixMilli(endTime)
 	latencies := map[string]model.HistoryLatency{
-		"P90": p.getP9xs(key, todayTsMill, endTime, 0.9, 500.0),
-		"P95": p.getP9xs(key, todayTsMill, endTime, 0.95, 500.0),
-		"P99": p.getP9xs(key, todayTsMill, endTime, 0.99, 500.0),
+		"P90": p.getP9xs(key, endTime, 0.9, 500.0),
+		"P95": p.getP9xs(key, endTime, 0.95, 500.0),
+		"P99": p.getP9xs(key, endTime, 0.99, 500.0),
 	}
 	sloHistory.Latency = latencies
 	return sloHistory, nil
@@ -229,26 +229,18 @@ func (p *PrometheusChecker) ListEntry(service string, startTimeMill int64, endTi
 	return targets, err
 }
 
-const (
-	dayDuration  = "24h"
-	hourDuration = "1h"
-)
-
-func (p *PrometheusChecker) getP9xs(key model.SLOEntryKey, todayTsMill int64, nowTsMill int64, percentile float64, defaultValue float64) model.HistoryLatency {
+func (p *PrometheusChecker) getP9xs(key model.SLOEntryKey, nowTsMill int64, percentile float64, defaultValue float64) model.HistoryLatency {
 	latency := model.HistoryLatency{}
-	if value, err := p.QueryMetricMillTS(todayTsMill, pql.GetLatencyPercentilePQL(percentile, key.EntryURI, dayDuration, p.BucketLabelName())); err == nil && value != 0 {
-		latency.Range = "yesterday"
-		latency.Value = value / 1e6
-		return latency
-	}
-
-	if value, err := p.QueryMetricMillTS(nowTsMill, pql.GetLatencyPercentilePQL(percentile, key.EntryURI, hourDuration, p.BucketLabelName())); err == nil && value != 0 {
-		latency.Range = "last1h"
-		latency.Value = value / 1e6
-		return latency
+	for _, thresholdRange := range []cmodel.ThresholdRange{cmodel.RangeYesterday, cmodel.RangeLast1h} {
+		endTsMill, query := pql.GetLatencyPercentilePQLInRange(percentile, key.EntryURI, thresholdRange, nowTsMill*1e6, p.BucketLabelName())
+		if value, err := p.QueryMetricMillTS(endTsMill, query); err == nil && value != 0 {
+			latency.Range = string(thresholdRange)
+			latency.Value = value / 1e6
+			return latency
+		}
 	}
 
-	latency.Range = "constant"
+	latency.Range = string(cmodel.RangeConstant)
 	latency.Value = defaultValue
 	return latency
 }
@@ -133,12 +133,6 @@ func checkResult(c model.SLOConfig, value float64) *model.SLO {
 	}
 }
 
-func getDayUnixMilli(unixMilli int64) int64 {
-	i := time.UnixMilli(unixMilli)
-	todayStart := time.Date(i.Year(), i.Month(), i.Day(), 0, 0, 0, 0, i.Location())
-	return todayStart.UnixMilli()
-}
-
 func MergeStatus(oldStatus model.SLOStatus, newStatus model.SLOStatus) model.SLOStatus {
 	switch {
 	case newStatus == model.Unknown || newStatus == model.Achieved:
@@ -4,16 +4,12 @@ import (
 	"log"
 	"time"
 
+	cmodel "github.com/CloudDetail/apo-module/model/v1"
 	"github.com/CloudDetail/apo-module/slo/api/v1"
 	"github.com/CloudDetail/apo-module/slo/api/v1/model"
 	"github.com/CloudDetail/apo-module/slo/sdk/v1/pql"
 )
 
-const (
-	dayDuration  = "24h"
-	hourDuration = "1h"
-)
-
 func (ddst *DynamicDefaultSLOTarget) SetupConfigCache(storeSource api.ConfigManager) {
 	ddst.store = storeSource
 }
@@ -53,17 +49,16 @@ func (ddst *DynamicDefaultSLOTarget) DynamicRecalculateStore() {
 }
 
 func (ddst *DynamicDefaultSLOTarget) getYesterdayLatencyOrLastOneHourOrDefault(percentile float64, entryURI string, todayTSNano int64, nowTSNano int64, defaultValue float64) (float64, model.ExpectedSource) {
-	if value, err := ddst.PQLApi.QueryMetric(uint64(todayTSNano), pql.GetLatencyPercentilePQL(percentile, entryURI, dayDuration, ddst.PQLApi.BucketLabelName())); err == nil && value != 0 {
+	endTSMill, query := pql.GetLatencyPercentilePQLInRange(percentile, entryURI, cmodel.RangeYesterday, todayTSNano, ddst.PQLApi.BucketLabelName())
+	if value, err := ddst.PQLApi.QueryMetricMillTS(endTSMill, query); err == nil && value != 0 {
 		return value / 1e6, model.YesterdayExpectSource
 	}
-	if value, err := ddst.PQLApi.QueryMetric(uint64(nowTSNano), pql.GetLatencyPercentilePQL(percentile, entryURI, hourDuration, ddst.PQLApi.BucketLabelName())); err == nil && value != 0 {
-		return value / 1e6, model.LastHourExpectedSource
-	}
-	return defaultValue / 1e6, model.DefaultExpectSource
+	return ddst.getLastOneHourOrDefault(percentile, entryURI, nowTSNano, defaultValue)
 }
 
 func (ddst *DynamicDefaultSLOTarget) getLastOneHourOrDefault(percentile float64, entryURI string, nowTSNano int64, defaultValue float64) (float64, model.ExpectedSource) {
-	if value, err := ddst.PQLApi.QueryMetric(uint64(nowTSNano), pql.GetLatencyPercentilePQL(percentile, entryURI, hourDuration, ddst.PQLApi.BucketLabelName())); err == nil && value != 0 {
+	endTSMill, query := pql.GetLatencyPercentilePQLInRange(percentile, entryURI, cmodel.RangeLast1h, nowTSNano, ddst.PQLApi.BucketLabelName())
+	if value, err := ddst.PQLApi.QueryMetricMillTS(endTSMill, query); err == nil && value != 0 {
 		return value / 1e6, model.LastHourExpectedSource
 	}
 	return defaultValue / 1e6, model.DefaultExpectSource
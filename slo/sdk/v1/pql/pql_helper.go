@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	cmodel "github.com/CloudDetail/apo-module/model/v1"
 )
 
 const (
@@ -47,6 +49,13 @@ func GetLatencyPercentilePQL(percentile float64, content_key string, duration st
 	return fmt.Sprintf(LatencyPercentilePQLTemplate, percentile, content_key, duration, bucketLabelName)
 }
 
+// GetLatencyPercentilePQLInRange returns the query time and the percentile query in the baseline window of
+// thresholdRange for the time tsNano, day and hour boundaries are aligned in local time.
+func GetLatencyPercentilePQLInRange(percentile float64, content_key string, thresholdRange cmodel.ThresholdRange, tsNano int64, bucketLabelName string) (endTSMill int64, query string) {
+	endTSMill, duration := thresholdRange.GetRange(tsNano)
+	return endTSMill, GetLatencyPercentilePQL(percentile, content_key, duration, bucketLabelName)
+}
+
 func GetRequestCountIncreasedPQL(content_key string, duration string) string {
 	return fmt.Sprintf(RequestCountIncreasePQLTemplate, content_key, duration)
 }
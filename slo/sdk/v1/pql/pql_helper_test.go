@@ -0,0 +1,30 @@
+package pql
+
+import (
+	"testing"
+	"time"
+
+	cmodel "github.com/CloudDetail/apo-module/model/v1"
+)
+
+func TestGetLatencyPercentilePQLInRange(t *testing.T) {
+	ts := time.Date(2024, 3, 11, 10, 30, 0, 0, time.Local)
+	today := time.Date(2024, 3, 11, 0, 0, 0, 0, time.Local)
+	tests := []struct {
+		name      string
+		r         cmodel.ThresholdRange
+		wantEndTS int64
+		wantQuery string
+	}{
+		{"last1h", cmodel.RangeLast1h, ts.UnixMilli(), `histogram_quantile(0.900000,sum(increase(kindling_span_trace_duration_nanoseconds_bucket{content_key="/order"}[1h])) by (le))`},
+		{"yesterday", cmodel.RangeYesterday, today.UnixMilli(), `histogram_quantile(0.900000,sum(increase(kindling_span_trace_duration_nanoseconds_bucket{content_key="/order"}[` + cmodel.FormatPromDuration(today.Sub(today.AddDate(0, 0, -1))) + `])) by (le))`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotEndTS, gotQuery := GetLatencyPercentilePQLInRange(0.9, "/order", tt.r, ts.UnixNano(), "le")
+			if gotEndTS != tt.wantEndTS || gotQuery != tt.wantQuery {
+				t.Errorf("GetLatencyPercentilePQLInRange() = (%d, %s), want (%d, %s)", gotEndTS, gotQuery, tt.wantEndTS, tt.wantQuery)
+			}
+		})
+	}
+}